@@ -7,3 +7,22 @@ provider "smallstep" {
     team_id     = "94a7dd82-1360-4493-b1bf-b14a97c45786"
   }
 }
+
+# Target a staging team with a provider alias
+provider "smallstep" {
+  alias     = "staging"
+  api_url   = "https://gateway.staging.smallstep.com/api"
+  team_slug = "example-staging"
+
+  client_certificate = {
+    certificate_file     = "staging.crt"
+    private_key_file     = "staging.key"
+    private_key_password = var.staging_key_password
+  }
+}
+
+# Use the team and client certificate of a step CLI context
+provider "smallstep" {
+  alias        = "local"
+  step_context = "example"
+}
@@ -0,0 +1 @@
+terraform import smallstep_api_object.scep 2025-01-01:/authorities/d42dbe81-14e1-46a3-94b9-faa33d4c9f88/provisioners/6f1c4f5e-2b9a-4c8e-9f5d-8a2e3b7c1d04
@@ -0,0 +1,16 @@
+
+# A SCEP provisioner, which does not have a dedicated resource yet
+resource "smallstep_api_object" "scep" {
+  path        = "/authorities/${smallstep_authority.my_authority.id}/provisioners"
+  api_version = "2025-01-01"
+  body = jsonencode({
+    name                  = "SCEP"
+    type                  = "SCEP"
+    challenge             = var.scep_challenge
+    autogenerateDecrypter = true
+  })
+}
+
+output "scep_provisioner_created_at" {
+  value = jsondecode(smallstep_api_object.scep.response).createdAt
+}
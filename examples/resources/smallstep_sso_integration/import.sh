@@ -0,0 +1 @@
+terraform import smallstep_sso_integration.my_integration 8a3b6a7e-4f4b-4c39-9d0e-6c2d4c6c1b7e
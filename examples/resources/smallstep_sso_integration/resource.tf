@@ -0,0 +1,26 @@
+resource "smallstep_identity_provider" "my_idp" {
+  trust_roots = file("${path.module}/root.crt")
+}
+
+resource "smallstep_sso_integration" "my_integration" {
+  redirect_uri          = "https://example.com/callback"
+  lifecycle_failure_uri = "https://example.com/inactive"
+  store_secret          = true
+  depends_on            = [smallstep_identity_provider.my_idp]
+}
+
+# Existing identity provider clients can be moved to SSO integrations without
+# recreating them, which keeps their ID and secret. If the API has no SSO
+# integration with the client's ID, the next plan fails instead.
+moved {
+  from = smallstep_identity_provider_client.my_idp_client
+  to   = smallstep_sso_integration.my_integration
+}
+
+output "sso_integration_id" {
+  value = smallstep_sso_integration.my_integration.id
+}
+
+output "sso_integration_secret" {
+  value = smallstep_sso_integration.my_integration.secret
+}
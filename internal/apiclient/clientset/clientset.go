@@ -5,9 +5,22 @@ import (
 	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 )
 
+// The versions of the Smallstep API the provider has clients for.
+const (
+	Version20250101 = "2025-01-01"
+	Version20260501 = "2026-05-01"
+)
+
 // Clients holds every versioned Smallstep API client. It is the value passed as
 // the provider's ResourceData/DataSourceData.
 type Clients struct {
 	V20250101 *v20250101.Client
 	V20260501 *v20260501.Client
+
+	// Pinned uses the 2026-05-01 types but requests the API version set with
+	// the provider's api_version, so teams can move the resources every
+	// version serves to the newer API when they are ready.
+	Pinned *v20260501.Client
+	// APIVersion is the version Pinned requests.
+	APIVersion string
 }
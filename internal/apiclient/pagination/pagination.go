@@ -0,0 +1,87 @@
+// Package pagination gets every object of the Smallstep API's list operations.
+package pagination
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+)
+
+// PageSize is the number of objects requested in each page after the first.
+const PageSize = 100
+
+// All gets every page of a list. The page function is called with the cursor
+// of the page to get, or nil for the first page.
+func All[T any](what string, page func(after *string) (*http.Response, error)) ([]T, error) {
+	var all []T
+	var after *string
+	for {
+		list, next, err := get[T](what, func() (*http.Response, error) {
+			return page(after)
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+		if next == "" || len(list) == 0 {
+			return all, nil
+		}
+		after = &next
+	}
+}
+
+// List gets a list that isn't paginated because its operation takes no
+// pagination parameters. Since the rest of such a list can't be requested, a
+// response with the cursor of a next page is an error.
+func List[T any](what string, list func() (*http.Response, error)) ([]T, error) {
+	all, next, err := get[T](what, list)
+	if err != nil {
+		return nil, err
+	}
+	if next != "" {
+		return nil, fmt.Errorf("failed to list %s: the response is the first page of a list that can't be paginated", what)
+	}
+	return all, nil
+}
+
+// get makes a list request and returns the objects in the response and the
+// cursor of the next page, if any.
+func get[T any](what string, request func() (*http.Response, error)) ([]T, string, error) {
+	resp, err := request()
+	if err != nil {
+		return nil, "", fmt.Errorf("list %s: %w", what, err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, "", fmt.Errorf("read list %s response body: %w", what, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to list %s: %d: %s", what, resp.StatusCode, body)
+	}
+	var list []T
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s list: %w", what, err)
+	}
+	return list, resp.Header.Get("X-Next-Cursor"), nil
+}
+
+// V20250101 returns the pagination parameter to get the page after a cursor.
+// It's nil for the first page, since the generated clients send unset fields
+// of the parameter as "<nil>".
+func V20250101(after *string) *v20250101.Pagination {
+	if after == nil {
+		return nil
+	}
+	first := PageSize
+	return &v20250101.Pagination{After: after, First: &first}
+}
+
+// V20260501 is V20250101 for the 2026-05-01 client.
+func V20260501(after *string) *v20260501.Pagination {
+	return (*v20260501.Pagination)(V20250101(after))
+}
@@ -0,0 +1,100 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	"github.com/smallstep/terraform-provider-smallstep/internal/fakeapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, s *fakeapi.Server) *v20250101.Client {
+	client, err := v20250101.NewClient(s.URL, v20250101.WithRequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
+		r.Header.Set("Authorization", "Bearer "+fakeapi.Token)
+		return nil
+	}))
+	require.NoError(t, err)
+	return client
+}
+
+func TestAll(t *testing.T) {
+	s := fakeapi.New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	var want []string
+	for i := range 250 {
+		resp, err := client.PostDevices(ctx, &v20250101.PostDevicesParams{}, v20250101.DeviceRequest{
+			PermanentIdentifier: fmt.Sprintf("device-%d", i),
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		want = append(want, fmt.Sprintf("device-%d", i))
+	}
+
+	var pages int
+	devices, err := All[v20250101.Device]("devices", func(after *string) (*http.Response, error) {
+		pages++
+		return client.ListDevices(ctx, &v20250101.ListDevicesParams{
+			Pagination: V20250101(after),
+		})
+	})
+	require.NoError(t, err)
+	var got []string
+	for _, device := range devices {
+		got = append(got, device.PermanentIdentifier)
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, 3, pages)
+
+	s.Fail("ListDevices", http.StatusServiceUnavailable, 1)
+	_, err = All[v20250101.Device]("devices", func(after *string) (*http.Response, error) {
+		return client.ListDevices(ctx, &v20250101.ListDevicesParams{
+			Pagination: V20250101(after),
+		})
+	})
+	assert.ErrorContains(t, err, "failed to list devices: 503")
+}
+
+func TestList(t *testing.T) {
+	s := fakeapi.New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	resp, err := client.PostAuthorities(ctx, &v20250101.PostAuthoritiesParams{}, v20250101.PostAuthoritiesJSONRequestBody{
+		Name:        "Pagination",
+		AdminEmails: []string{"eng@example.com"},
+		Subdomain:   "pagination",
+		Type:        "devops",
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	list, err := List[v20250101.Authority]("authorities", func() (*http.Response, error) {
+		return client.GetAuthorities(ctx, &v20250101.GetAuthoritiesParams{})
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "Pagination", list[0].Name)
+
+	// A list that can't be paginated can't be completed if it has a next page.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Next-Cursor", "abc")
+		w.Write([]byte(`[{"name": "Pagination"}]`))
+	}))
+	defer srv.Close()
+	_, err = List[v20250101.Authority]("authorities", func() (*http.Response, error) {
+		return http.Get(srv.URL)
+	})
+	assert.ErrorContains(t, err, "can't be paginated")
+}
@@ -0,0 +1,248 @@
+// Package apispec routes and validates Smallstep API requests and responses
+// against the OpenAPI specs embedded in the generated clients. It is used by
+// tests to check that the provider only sends requests the API documents and
+// understands the responses it gets back.
+package apispec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+)
+
+// VersionHeader is the header that selects the API version of a request.
+const VersionHeader = "X-Smallstep-Api-Version"
+
+// ErrUnknownVersion is returned for requests without a supported API version.
+var ErrUnknownVersion = errors.New("unsupported API version")
+
+var specs = map[string]func() (*openapi3.T, error){
+	"2025-01-01": v20250101.GetSwagger,
+	"2026-05-01": v20260501.GetSwagger,
+}
+
+// Load returns the spec of an API version, with the changes needed for it to
+// describe how the API behaves.
+func Load(version string) (*openapi3.T, error) {
+	getSwagger, ok := specs[version]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownVersion, version)
+	}
+	spec, err := getSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the %s spec: %w", version, err)
+	}
+	fix(spec)
+	return spec, nil
+}
+
+// Router finds the operation of a request in the spec of its API version.
+type Router struct {
+	routes map[string][]*routers.Route
+}
+
+// NewRouter loads the spec of every API version.
+func NewRouter() (*Router, error) {
+	r := &Router{routes: map[string][]*routers.Route{}}
+	for version := range specs {
+		spec, err := Load(version)
+		if err != nil {
+			return nil, err
+		}
+		for p, item := range spec.Paths.Map() {
+			for method, op := range item.Operations() {
+				r.routes[version] = append(r.routes[version], &routers.Route{
+					Spec:      spec,
+					Path:      p,
+					PathItem:  item,
+					Method:    method,
+					Operation: op,
+				})
+			}
+		}
+		// Routes with fewer path parameters take precedence, so
+		// /authorities/csr is not matched as an authority ID.
+		sort.SliceStable(r.routes[version], func(i, j int) bool {
+			return strings.Count(r.routes[version][i].Path, "{") < strings.Count(r.routes[version][j].Path, "{")
+		})
+	}
+	return r, nil
+}
+
+// Find returns the route and path parameters of a request with the API
+// version, method and escaped path, relative to the API's base URL. The error
+// is ErrUnknownVersion, routers.ErrPathNotFound or routers.ErrMethodNotAllowed
+// when there's no matching route.
+func (r *Router) Find(version, method, escapedPath string) (*routers.Route, map[string]string, error) {
+	routes, ok := r.routes[version]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w %q", ErrUnknownVersion, version)
+	}
+	segments, err := SplitPath(escapedPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	pathFound := false
+	for _, rt := range routes {
+		params, ok := match(rt.Path, segments)
+		if !ok {
+			continue
+		}
+		if rt.Method == method {
+			return rt, params, nil
+		}
+		pathFound = true
+	}
+	if pathFound {
+		return nil, nil, routers.ErrMethodNotAllowed
+	}
+	return nil, nil, routers.ErrPathNotFound
+}
+
+// FindRoute implements routers.Router for requests sent to the root of the
+// API.
+func (r *Router) FindRoute(req *http.Request) (*routers.Route, map[string]string, error) {
+	return r.Find(req.Header.Get(VersionHeader), req.Method, req.URL.EscapedPath())
+}
+
+var _ routers.Router = (*Router)(nil)
+
+// SplitPath splits an escaped path into unescaped segments.
+func SplitPath(escapedPath string) ([]string, error) {
+	parts := strings.Split(strings.Trim(escapedPath, "/"), "/")
+	for i, p := range parts {
+		seg, err := url.PathUnescape(p)
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = seg
+	}
+	return parts, nil
+}
+
+// IsParam reports whether a segment of a spec path is a path parameter, e.g.
+// {authorityID}.
+func IsParam(seg string) bool {
+	return strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")
+}
+
+func match(path string, segments []string) (map[string]string, bool) {
+	pattern := strings.Split(strings.Trim(path, "/"), "/")
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, seg := range pattern {
+		if IsParam(seg) {
+			params[strings.Trim(seg, "{}")] = segments[i]
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// ValidateRequest validates the parameters and body of a request. The request
+// body is read and replaced, so the request can still be sent.
+func ValidateRequest(ctx context.Context, route *routers.Route, params map[string]string, r *http.Request) error {
+	body, err := readBody(&r.Body)
+	if err != nil {
+		return err
+	}
+	stripped := withoutNulls(body)
+	validate := r.Clone(ctx)
+	validate.Body = io.NopCloser(bytes.NewReader(stripped))
+	validate.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(stripped)), nil
+	}
+	return openapi3filter.ValidateRequest(ctx, requestInput(route, params, validate))
+}
+
+// ValidateResponse validates the body of a response to the request. Responses
+// with a status the operation doesn't document are not validated.
+func ValidateResponse(ctx context.Context, route *routers.Route, params map[string]string, r *http.Request, status int, header http.Header, body []byte) error {
+	return openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput(route, params, r),
+		Status:                 status,
+		Header:                 header,
+		Body:                   io.NopCloser(bytes.NewReader(withoutNulls(body))),
+	})
+}
+
+func requestInput(route *routers.Route, params map[string]string, r *http.Request) *openapi3filter.RequestValidationInput {
+	return &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: params,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			// The API ignores read-only properties like IDs in requests.
+			ExcludeReadOnlyValidations: true,
+			AuthenticationFunc:         openapi3filter.NoopAuthenticationFunc,
+		},
+	}
+}
+
+// readBody reads a body and replaces it with a reader of the same bytes.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// withoutNulls removes null properties from a JSON body. The API treats null
+// properties as missing, and the generated clients send null for unset
+// properties the spec doesn't mark as nullable. Bodies that aren't JSON
+// objects are returned unchanged.
+func withoutNulls(body []byte) []byte {
+	var v map[string]any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+	RemoveNulls(v)
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return b
+}
+
+// RemoveNulls removes null properties from a decoded JSON value, including
+// nested objects.
+func RemoveNulls(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if val == nil {
+				delete(v, k)
+				continue
+			}
+			RemoveNulls(val)
+		}
+	case []any:
+		for _, val := range v {
+			RemoveNulls(val)
+		}
+	}
+}
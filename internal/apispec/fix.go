@@ -0,0 +1,139 @@
+package apispec
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// fix changes the parts of the spec that don't describe how the API
+// behaves, so requests the API accepts pass validation and responses have the
+// documented shape.
+func fix(spec *openapi3.T) {
+	addDiscriminators(spec)
+
+	// Creating a managed RADIUS server returns 201, not 200.
+	if item := spec.Paths.Find("/managed-radius"); item != nil && item.Post != nil {
+		responses := item.Post.Responses
+		if resp := responses.Value("200"); resp != nil && responses.Value("201") == nil {
+			responses.Set("201", resp)
+			responses.Delete("200")
+		}
+	}
+
+	// A oneOf with an empty schema, used for properties that can be unset,
+	// matches both schemas for every value set.
+	walkSchemas(spec, func(sc *openapi3.Schema) {
+		if slices.ContainsFunc(sc.OneOf, func(ref *openapi3.SchemaRef) bool { return ref.Ref == "" && ref.Value.IsEmpty() }) {
+			sc.AnyOf, sc.OneOf = sc.OneOf, nil
+		}
+	})
+
+	// The authority and certificate fields of a credential are optional.
+	if ref, ok := spec.Components.Schemas["credentialCertificate"]; ok {
+		ref.Value.Required = slices.DeleteFunc(slices.Clone(ref.Value.Required), func(name string) bool {
+			return name == "authorityID" || name == "fields"
+		})
+	}
+
+	// The API treats a missing list as empty.
+	walkSchemas(spec, func(sc *openapi3.Schema) {
+		sc.Required = slices.DeleteFunc(slices.Clone(sc.Required), func(name string) bool {
+			prop, ok := sc.Properties[name]
+			return ok && prop.Value.Type.Is(openapi3.TypeArray)
+		})
+	})
+
+	// The identity provider client endpoints return clients, not the
+	// identity provider.
+	if client, ok := spec.Components.Schemas["idpClient"]; ok {
+		list := openapi3.NewArraySchema()
+		list.Items = client
+		for _, p := range []string{"/sso/clients", "/sso/clients/{idpClientID}"} {
+			item := spec.Paths.Find(p)
+			if item == nil {
+				continue
+			}
+			for method, op := range item.Operations() {
+				for code, resp := range op.Responses.Map() {
+					mt := resp.Value.Content.Get("application/json")
+					if !strings.HasPrefix(code, "2") || mt == nil {
+						continue
+					}
+					if method == http.MethodGet && !strings.HasSuffix(p, "}") {
+						mt.Schema = list.NewRef()
+					} else {
+						mt.Schema = client
+					}
+				}
+			}
+		}
+	}
+}
+
+// addDiscriminators maps the type of schemas like provisioner, defined as the
+// allOf of common properties and a oneOf of one schema per type, to the schema
+// for each type. The specs don't declare these discriminators, but the API
+// picks the schema by type, and without them a request that is valid for more
+// than one type would be rejected.
+func addDiscriminators(spec *openapi3.T) {
+	for name, ref := range spec.Components.Schemas {
+		var types []any
+		var oneOf *openapi3.Schema
+		for _, part := range ref.Value.AllOf {
+			if t, ok := part.Value.Properties["type"]; ok {
+				types = t.Value.Enum
+			}
+			if len(part.Value.OneOf) > 0 && part.Value.Discriminator == nil {
+				oneOf = part.Value
+			}
+		}
+		if len(types) == 0 || oneOf == nil {
+			continue
+		}
+		mapping := openapi3.StringMap{}
+		for _, t := range types {
+			want := strings.ToLower(strings.ReplaceAll(fmt.Sprint(t), "_", "") + name)
+			for _, variant := range oneOf.OneOf {
+				if strings.ToLower(path.Base(variant.Ref)) == want {
+					mapping[fmt.Sprint(t)] = variant.Ref
+				}
+			}
+		}
+		if len(mapping) == len(types) {
+			oneOf.Discriminator = &openapi3.Discriminator{PropertyName: "type", Mapping: mapping}
+		}
+	}
+}
+
+// walkSchemas calls fn once for every schema in the spec's components,
+// including the schemas of their properties, items and compositions.
+func walkSchemas(spec *openapi3.T, fn func(*openapi3.Schema)) {
+	seen := map[*openapi3.Schema]bool{}
+	var walk func(*openapi3.SchemaRef)
+	walk = func(ref *openapi3.SchemaRef) {
+		if ref == nil || ref.Value == nil || seen[ref.Value] {
+			return
+		}
+		sc := ref.Value
+		seen[sc] = true
+		fn(sc)
+		for _, prop := range sc.Properties {
+			walk(prop)
+		}
+		walk(sc.Items)
+		walk(sc.AdditionalProperties.Schema)
+		for _, refs := range []openapi3.SchemaRefs{sc.AllOf, sc.OneOf, sc.AnyOf} {
+			for _, ref := range refs {
+				walk(ref)
+			}
+		}
+	}
+	for _, ref := range spec.Components.Schemas {
+		walk(ref)
+	}
+}
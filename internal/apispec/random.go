@@ -0,0 +1,234 @@
+package apispec
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
+)
+
+// maxDepth limits how deeply random objects nest, for recursive schemas.
+const maxDepth = 8
+
+// cachedSpecs loads each spec once for Random, which is called in loops.
+var cachedSpecs = func() map[string]func() (*openapi3.T, error) {
+	m := map[string]func() (*openapi3.T, error){}
+	for version := range specs {
+		m[version] = sync.OnceValues(func() (*openapi3.T, error) { return Load(version) })
+	}
+	return m
+}()
+
+// Random fills v with a random object that matches a schema of the spec, the
+// way the API could return it: read-only properties may be set and write-only
+// properties are not. Optional properties and list items are added at random.
+func Random(rng *rand.Rand, version, schemaName string, v any) error {
+	load, ok := cachedSpecs[version]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownVersion, version)
+	}
+	spec, err := load()
+	if err != nil {
+		return err
+	}
+	ref, ok := spec.Components.Schemas[schemaName]
+	if !ok {
+		return fmt.Errorf("the %s spec has no schema %q", version, schemaName)
+	}
+	b, err := json.Marshal(randomValue(rng, ref.Value, 0))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func randomValue(rng *rand.Rand, sc *openapi3.Schema, depth int) any {
+	if len(sc.Enum) > 0 {
+		return sc.Enum[rng.IntN(len(sc.Enum))]
+	}
+	if len(sc.OneOf) > 0 || len(sc.AnyOf) > 0 || len(sc.AllOf) > 0 {
+		return randomComposition(rng, sc, depth)
+	}
+	switch {
+	case sc.Type.Is(openapi3.TypeObject) || (sc.Type == nil && len(sc.Properties) > 0):
+		return randomObject(rng, sc, depth)
+	case sc.Type.Is(openapi3.TypeArray):
+		list := []any{}
+		if sc.Items != nil && depth < maxDepth {
+			for range rng.IntN(3) + int(sc.MinItems) {
+				item := randomValue(rng, sc.Items.Value, depth+1)
+				if sc.UniqueItems && slices.ContainsFunc(list, func(v any) bool { return reflect.DeepEqual(v, item) }) {
+					continue
+				}
+				list = append(list, item)
+			}
+		}
+		return list
+	case sc.Type.Is(openapi3.TypeBoolean):
+		return rng.IntN(2) == 0
+	case sc.Type.Is(openapi3.TypeInteger), sc.Type.Is(openapi3.TypeNumber):
+		lo, hi := 0, 1000
+		if sc.Min != nil {
+			lo = int(*sc.Min)
+		}
+		if sc.Max != nil {
+			hi = int(*sc.Max)
+		}
+		n := lo + rng.IntN(hi-lo+1)
+		if sc.MultipleOf != nil {
+			m := int(*sc.MultipleOf)
+			n = max(lo+m-1, n) / m * m
+		}
+		return n
+	case sc.Type.Is(openapi3.TypeString):
+		return randomString(rng, sc)
+	}
+	return randomString(rng, sc)
+}
+
+func randomObject(rng *rand.Rand, sc *openapi3.Schema, depth int) map[string]any {
+	obj := map[string]any{}
+	required := map[string]bool{}
+	for _, name := range sc.Required {
+		required[name] = true
+	}
+	// Properties are visited in order so a seed always gives the same object.
+	for _, name := range slices.Sorted(maps.Keys(sc.Properties)) {
+		prop := sc.Properties[name]
+		if prop.Value.WriteOnly {
+			continue
+		}
+		if !required[name] && (depth >= maxDepth || rng.IntN(2) == 0) {
+			continue
+		}
+		obj[name] = randomValue(rng, prop.Value, depth+1)
+	}
+	if len(sc.Properties) == 0 && sc.AdditionalProperties.Schema != nil && depth < maxDepth {
+		for range rng.IntN(3) {
+			obj[randomString(rng, &openapi3.Schema{})] = randomValue(rng, sc.AdditionalProperties.Schema.Value, depth+1)
+		}
+	}
+	return obj
+}
+
+// maxAttempts is the number of values randomComposition tries before giving up
+// on one that matches the schema.
+const maxAttempts = 20
+
+// randomComposition merges the objects of every allOf schema with one schema
+// of the oneOf and anyOf lists. A discriminator property is set to the value
+// that maps to the chosen schema. Since a value built for one schema of a oneOf
+// can match others too, values are generated until one matches.
+func randomComposition(rng *rand.Rand, sc *openapi3.Schema, depth int) any {
+	var v any
+	for range maxAttempts {
+		v = randomCompositionAttempt(rng, sc, depth)
+		if sc.VisitJSON(v, openapi3.VisitAsResponse()) == nil {
+			break
+		}
+	}
+	return v
+}
+
+func randomCompositionAttempt(rng *rand.Rand, sc *openapi3.Schema, depth int) any {
+	var parts []*openapi3.Schema
+	discriminated := map[string]any{}
+	for _, ref := range sc.AllOf {
+		parts = append(parts, ref.Value)
+	}
+	for _, refs := range []openapi3.SchemaRefs{sc.OneOf, sc.AnyOf} {
+		var choices openapi3.SchemaRefs
+		for _, ref := range refs {
+			if !ref.Value.IsEmpty() {
+				choices = append(choices, ref)
+			}
+		}
+		if len(choices) == 0 {
+			continue
+		}
+		choice := choices[rng.IntN(len(choices))]
+		parts = append(parts, choice.Value)
+		if d := sc.Discriminator; d != nil {
+			for _, value := range slices.Sorted(maps.Keys(d.Mapping)) {
+				if d.Mapping[value] == choice.Ref {
+					discriminated[d.PropertyName] = value
+				}
+			}
+		}
+	}
+	if len(parts) == 0 {
+		return randomString(rng, sc)
+	}
+	if len(parts) == 1 && len(sc.Properties) == 0 && len(discriminated) == 0 {
+		return randomValue(rng, parts[0], depth)
+	}
+	obj := randomObject(rng, sc, depth)
+	for _, part := range parts {
+		v, ok := randomValue(rng, part, depth).(map[string]any)
+		if !ok {
+			continue
+		}
+		for name, val := range v {
+			obj[name] = val
+		}
+	}
+	// Schemas like {"required": ["value"]} choose between the properties of
+	// the parent schema.
+	for _, part := range parts {
+		for _, name := range part.Required {
+			if prop, ok := sc.Properties[name]; ok && obj[name] == nil {
+				obj[name] = randomValue(rng, prop.Value, depth+1)
+			}
+		}
+	}
+	for name, val := range discriminated {
+		obj[name] = val
+	}
+	return obj
+}
+
+const alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func randomString(rng *rand.Rand, sc *openapi3.Schema) string {
+	switch sc.Format {
+	case "uuid":
+		var b [16]byte
+		for i := range b {
+			b[i] = byte(rng.IntN(256))
+		}
+		return uuid.Must(uuid.FromBytes(b[:])).String()
+	case "date-time":
+		return time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(rng.IntN(1_000_000)) * time.Second).Format(time.RFC3339)
+	case "email":
+		return randomString(rng, &openapi3.Schema{}) + "@example.com"
+	case "byte":
+		b := make([]byte, 1+rng.IntN(16))
+		for i := range b {
+			b[i] = byte(rng.IntN(256))
+		}
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	// Random strings match the ".+" patterns, and the only other patterns
+	// in the specs are for email addresses.
+	if strings.Contains(sc.Pattern, "@") {
+		return randomString(rng, &openapi3.Schema{Format: "email"})
+	}
+	n := int(sc.MinLength)
+	if n == 0 {
+		n = 1 + rng.IntN(10)
+	}
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[rng.IntN(len(alphabet))]
+	}
+	return string(b)
+}
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+// ambiguous are schemas with a oneOf that is chosen by a property of the
+// parent object, so random values can match more than one of its schemas.
+var ambiguous = map[string]bool{
+	"accountRequest":               true,
+	"endpointCertificateInfo":      true,
+	"endpointConfigurationRequest": true,
+}
+
+func TestRandom(t *testing.T) {
+	for version := range specs {
+		spec, err := Load(version)
+		require.NoError(t, err)
+		for name, ref := range spec.Components.Schemas {
+			if ambiguous[name] {
+				continue
+			}
+			t.Run(version+"/"+name, func(t *testing.T) {
+				for seed := range uint64(20) {
+					rng := rand.New(rand.NewPCG(seed, 0))
+					var v any
+					require.NoError(t, Random(rng, version, name, &v))
+					err := ref.Value.VisitJSON(v, openapi3.VisitAsResponse(), openapi3.MultiErrors())
+					if err != nil {
+						b, _ := json.Marshal(v)
+						t.Fatalf("seed %d: %s: %v", seed, b, err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package apispec
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Transport is a round tripper for tests that validates every request against
+// the spec of its API version before sending it, and every response before
+// returning it. A request or response that doesn't match the spec fails the
+// round trip with a *ContractError.
+type Transport struct {
+	base     http.RoundTripper
+	router   *Router
+	basePath string
+}
+
+// ContractError describes a request or response that doesn't match the spec.
+type ContractError struct {
+	Operation string
+	Response  bool
+	Err       error
+}
+
+func (e *ContractError) Error() string {
+	what := "request"
+	if e.Response {
+		what = "response"
+	}
+	return fmt.Sprintf("%s %s does not match the API spec: %v", e.Operation, what, e.Err)
+}
+
+func (e *ContractError) Unwrap() error {
+	return e.Err
+}
+
+// sharedRouter loads the specs once for every transport, since parsing them
+// is slow.
+var sharedRouter = sync.OnceValues(NewRouter)
+
+// NewTransport returns a transport for requests to the API at server, e.g.
+// https://gateway.smallstep.com/api, that sends requests with base.
+func NewTransport(server string, base http.RoundTripper) (*Transport, error) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API URL %q: %w", server, err)
+	}
+	router, err := sharedRouter()
+	if err != nil {
+		return nil, err
+	}
+	return &Transport{
+		base:     base,
+		router:   router,
+		basePath: strings.TrimSuffix(u.EscapedPath(), "/"),
+	}, nil
+}
+
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+	op := r.Method + " " + r.URL.Path
+
+	path, ok := strings.CutPrefix(r.URL.EscapedPath(), t.basePath)
+	if !ok {
+		return nil, &ContractError{Operation: op, Err: fmt.Errorf("path is not under %s", t.basePath)}
+	}
+	route, params, err := t.router.Find(r.Header.Get(VersionHeader), r.Method, path)
+	if err != nil {
+		return nil, &ContractError{Operation: op, Err: err}
+	}
+	op = route.Operation.OperationID
+
+	// RoundTrip must not modify the caller's request.
+	r = r.Clone(ctx)
+	if err := ValidateRequest(ctx, route, params, r); err != nil {
+		return nil, &ContractError{Operation: op, Err: err}
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateResponse(ctx, route, params, r, resp.StatusCode, resp.Header, body); err != nil {
+		return nil, &ContractError{Operation: op, Response: true, Err: err}
+	}
+	return resp, nil
+}
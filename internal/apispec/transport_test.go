@@ -0,0 +1,157 @@
+package apispec
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		version      string
+		body         string
+		status       int
+		respBody     string
+		wantErr      string
+		wantResponse bool
+	}{
+		{
+			name:     "ok",
+			method:   http.MethodPost,
+			path:     "/api/authorities",
+			version:  "2025-01-01",
+			body:     `{"name":"Test","subdomain":"test","type":"devops","adminEmails":["eng@example.com"]}`,
+			status:   http.StatusCreated,
+			respBody: `{"id":"d6a9fb0b-8f5e-4c7e-9a8e-1b0c6f6a0c1e","name":"Test","domain":"test.ca.smallstep.com","type":"devops","createdAt":"2025-01-01T00:00:00Z"}`,
+		},
+		{
+			name:    "missing required property",
+			method:  http.MethodPost,
+			path:    "/api/authorities",
+			version: "2025-01-01",
+			body:    `{"name":"Test","type":"devops","adminEmails":["eng@example.com"]}`,
+			wantErr: `property "subdomain" is missing`,
+		},
+		{
+			name:    "wrong enum",
+			method:  http.MethodPost,
+			path:    "/api/authorities",
+			version: "2025-01-01",
+			body:    `{"name":"Test","subdomain":"test","type":"enterprise","adminEmails":["eng@example.com"]}`,
+			wantErr: `value is not one of the allowed values`,
+		},
+		{
+			name:    "nulls are ignored",
+			method:  http.MethodPost,
+			path:    "/api/authorities",
+			version: "2025-01-01",
+			body:    `{"name":"Test","subdomain":"test","type":"devops","adminEmails":["eng@example.com"],"activeRevocation":null}`,
+			status:  http.StatusCreated,
+			respBody: `{"id":"d6a9fb0b-8f5e-4c7e-9a8e-1b0c6f6a0c1e","name":"Test","domain":"test.ca.smallstep.com","type":"devops",` +
+				`"createdAt":"2025-01-01T00:00:00Z","root":null}`,
+		},
+		{
+			name:         "invalid response",
+			method:       http.MethodGet,
+			path:         "/api/authorities/test",
+			version:      "2025-01-01",
+			status:       http.StatusOK,
+			respBody:     `{"id":"d6a9fb0b-8f5e-4c7e-9a8e-1b0c6f6a0c1e","name":"Test","type":"devops"}`,
+			wantErr:      `property "domain" is missing`,
+			wantResponse: true,
+		},
+		{
+			name:     "undocumented status",
+			method:   http.MethodGet,
+			path:     "/api/authorities/test",
+			version:  "2025-01-01",
+			status:   http.StatusServiceUnavailable,
+			respBody: `unavailable`,
+		},
+		{
+			name:    "unknown path",
+			method:  http.MethodGet,
+			path:    "/api/widgets",
+			version: "2025-01-01",
+			wantErr: "no matching operation was found",
+		},
+		{
+			name:    "unknown version",
+			method:  http.MethodGet,
+			path:    "/api/authorities",
+			version: "2020-01-01",
+			wantErr: "unsupported API version",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sent := false
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sent = true
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.body, string(body))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				io.WriteString(w, tc.respBody)
+			}))
+			defer srv.Close()
+
+			transport, err := NewTransport(srv.URL+"/api", http.DefaultTransport)
+			require.NoError(t, err)
+
+			var body io.Reader = http.NoBody
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, srv.URL+tc.path, body)
+			require.NoError(t, err)
+			req.Header.Set(VersionHeader, tc.version)
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				defer resp.Body.Close()
+				got, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tc.respBody, string(got))
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+			var contractErr *ContractError
+			require.True(t, errors.As(err, &contractErr))
+			assert.Equal(t, tc.wantResponse, contractErr.Response)
+			assert.Equal(t, tc.wantResponse, sent, "invalid requests are not sent")
+		})
+	}
+}
+
+func TestRouter_Find(t *testing.T) {
+	router, err := NewRouter()
+	require.NoError(t, err)
+
+	route, params, err := router.Find("2025-01-01", http.MethodGet, "/authorities/example.ca.smallstep.com/provisioners/my%2Fprovisioner")
+	require.NoError(t, err)
+	assert.Equal(t, "/authorities/{authorityID}/provisioners/{provisionerNameOrID}", route.Path)
+	assert.Equal(t, map[string]string{"authorityID": "example.ca.smallstep.com", "provisionerNameOrID": "my/provisioner"}, params)
+
+	route, _, err = router.Find("2025-01-01", http.MethodPost, "/authorities/csr")
+	require.NoError(t, err)
+	assert.Equal(t, "/authorities/csr", route.Path)
+
+	_, _, err = router.Find("2025-01-01", http.MethodPatch, "/authorities")
+	assert.ErrorIs(t, err, routers.ErrMethodNotAllowed)
+}
@@ -0,0 +1,483 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+)
+
+const driftUsage = `Usage: terraform-provider-smallstep drift [options]
+
+Reads a terraform state file and reports every smallstep resource whose object
+was changed or deleted outside of terraform, with a diff of each changed
+attribute. Objects are read with the same logic as "terraform refresh", and
+JSON and duration attributes with equivalent values are not reported as
+changed.
+
+The API URL, credentials and API version are read from the same environment
+variables as the provider, e.g. SMALLSTEP_API_TOKEN, or from the step CLI
+context set with SMALLSTEP_CONTEXT.
+
+Exits with 0 if nothing drifted, 2 if something drifted and 1 on errors.
+
+Options:
+`
+
+// stateFile is the part of a terraform state file (format version 4) with
+// the resource instances.
+type stateFile struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey      any             `json:"index_key"`
+			SchemaVersion int64           `json:"schema_version"`
+			Attributes    json.RawMessage `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// jsonAttributes and durationAttributes are the string attributes that hold
+// JSON or a duration, by resource type. Like the resources, drift compares
+// their values by meaning, e.g. 24h is the same as 1440m. Other strings are
+// compared exactly.
+var (
+	jsonAttributes = map[string][]string{
+		"smallstep_api_object":  {"body"},
+		"smallstep_provisioner": {"jwk.key", "options.ssh.template_data", "options.x509.template_data"},
+	}
+	durationAttributes = map[string][]string{
+		"smallstep_credential": {"certificate.duration"},
+		"smallstep_provisioner": {
+			"aws.instance_age",
+			"claims.default_host_ssh_cert_duration",
+			"claims.default_tls_cert_duration",
+			"claims.default_user_ssh_cert_duration",
+			"claims.max_host_ssh_cert_duration",
+			"claims.max_tls_cert_duration",
+			"claims.max_user_ssh_cert_duration",
+			"claims.min_host_ssh_cert_duration",
+			"claims.min_tls_cert_duration",
+			"claims.min_user_ssh_cert_duration",
+			"gcp.instance_age",
+		},
+	}
+)
+
+// drift is the difference between an object in state and the live object.
+type drift struct {
+	address string
+	deleted bool
+	changes []change
+}
+
+// change is a changed attribute, with its values rendered as JSON.
+type change struct {
+	path     string
+	old, new string
+}
+
+// Drift runs the drift subcommand and returns the process exit code.
+func Drift(ctx context.Context, version string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("drift", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	statePath := flags.String("state", "terraform.tfstate", `path of the state file, e.g. from "terraform state pull", or - to read it from stdin`)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, driftUsage)
+		flags.PrintDefaults()
+	}
+	// Exit code 2 means drift, so flag errors exit with 1.
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+
+	var b []byte
+	var err error
+	if *statePath == "-" {
+		b, err = io.ReadAll(stdin)
+	} else {
+		b, err = os.ReadFile(*statePath)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "drift: %v\n", err)
+		return 1
+	}
+	var state stateFile
+	if err := json.Unmarshal(b, &state); err != nil {
+		fmt.Fprintf(stderr, "drift: parse state file: %v\n", err)
+		return 1
+	}
+	if state.Version != 4 {
+		fmt.Fprintf(stderr, "drift: unsupported state file format version %d\n", state.Version)
+		return 1
+	}
+
+	s, err := newSession(ctx, version)
+	if err != nil {
+		fmt.Fprintf(stderr, "drift: %v\n", err)
+		return 1
+	}
+
+	drifts, checked, errs := detectDrift(ctx, s, state)
+	for _, d := range drifts {
+		if d.deleted {
+			fmt.Fprintf(stdout, "%s has been deleted\n", d.address)
+			continue
+		}
+		fmt.Fprintf(stdout, "%s has changed\n", d.address)
+		for _, c := range d.changes {
+			fmt.Fprintf(stdout, "  ~ %s: %s -> %s\n", c.path, c.old, c.new)
+		}
+	}
+	for _, err := range errs {
+		fmt.Fprintf(stderr, "drift: %v\n", err)
+	}
+
+	switch {
+	case len(errs) > 0:
+		return 1
+	case len(drifts) > 0:
+		fmt.Fprintf(stdout, "\n%d of %d objects drifted.\n", len(drifts), checked)
+		return 2
+	default:
+		fmt.Fprintf(stdout, "No drift in %d objects.\n", checked)
+		return 0
+	}
+}
+
+// detectDrift reads every smallstep resource instance in the state and
+// returns the ones that drifted and the number of instances checked.
+func detectDrift(ctx context.Context, s *session, state stateFile) ([]drift, int, []error) {
+	var drifts []drift
+	var errs []error
+	checked := 0
+	for _, rs := range state.Resources {
+		if rs.Mode != "managed" || !strings.HasPrefix(rs.Type, "smallstep_") {
+			continue
+		}
+		for _, instance := range rs.Instances {
+			address := resourceAddress(rs.Module, rs.Type, rs.Name, instance.IndexKey)
+			d, err := s.drift(ctx, rs.Type, instance.SchemaVersion, instance.Attributes)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", address, err))
+				continue
+			}
+			checked++
+			if d != nil {
+				d.address = address
+				drifts = append(drifts, *d)
+			}
+		}
+	}
+	return drifts, checked, errs
+}
+
+// drift reads the object of a resource instance and compares it with the
+// instance's state. It returns nil if the object didn't drift.
+func (s *session) drift(ctx context.Context, typeName string, version int64, attributes json.RawMessage) (*drift, error) {
+	r, err := s.resource(ctx, typeName)
+	if err != nil {
+		return nil, err
+	}
+	prior, err := s.stateFromJSON(ctx, r, typeName, version, attributes)
+	if err != nil {
+		return nil, err
+	}
+	live, err := s.read(ctx, r, typeName, prior)
+	if err != nil {
+		return nil, err
+	}
+	if live.Raw.IsNull() {
+		return &drift{deleted: true}, nil
+	}
+
+	changes, err := diffState(typeName, prior, live)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &drift{changes: changes}, nil
+}
+
+// diffState returns the configurable attributes that differ between two
+// states of a resource.
+func diffState(typeName string, prior, live tfsdk.State) ([]change, error) {
+	sch, ok := prior.Schema.(schema.Schema)
+	if !ok {
+		return nil, fmt.Errorf("%s: unexpected schema %T", typeName, prior.Schema)
+	}
+	var old, current map[string]tftypes.Value
+	if err := prior.Raw.As(&old); err != nil {
+		return nil, err
+	}
+	if err := live.Raw.As(&current); err != nil {
+		return nil, err
+	}
+	d := differ{
+		secrets:   secretAttributes[typeName],
+		json:      jsonAttributes[typeName],
+		durations: durationAttributes[typeName],
+	}
+	if err := d.attributes(nil, sch.Attributes, old, current); err != nil {
+		return nil, err
+	}
+	return d.changes, nil
+}
+
+type differ struct {
+	secrets   []string
+	json      []string
+	durations []string
+	changes   []change
+}
+
+// attributes compares the attributes of two objects. Attributes that are only
+// computed are left out, like the timestamps the API updates itself.
+func (d *differ) attributes(parent []string, attrs map[string]schema.Attribute, old, current map[string]tftypes.Value) error {
+	var names []string
+	for name := range attrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		attr := attrs[name]
+		if attr.IsComputed() && !attr.IsOptional() && !attr.IsRequired() {
+			continue
+		}
+		p := append(slices.Clone(parent), name)
+		if err := d.attribute(p, attr, old[name], current[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *differ) attribute(p []string, attr schema.Attribute, old, current tftypes.Value) error {
+	if !old.IsKnown() || !current.IsKnown() {
+		return nil
+	}
+	var nested map[string]schema.Attribute
+	switch attr := attr.(type) {
+	case schema.SingleNestedAttribute:
+		if !old.IsNull() && !current.IsNull() {
+			var oldAttrs, currentAttrs map[string]tftypes.Value
+			if err := old.As(&oldAttrs); err != nil {
+				return err
+			}
+			if err := current.As(&currentAttrs); err != nil {
+				return err
+			}
+			return d.attributes(p, attr.Attributes, oldAttrs, currentAttrs)
+		}
+	case schema.ListNestedAttribute:
+		nested = attr.NestedObject.Attributes
+	}
+
+	if nested != nil && !old.IsNull() && !current.IsNull() {
+		var oldElems, currentElems []tftypes.Value
+		if err := old.As(&oldElems); err != nil {
+			return err
+		}
+		if err := current.As(&currentElems); err != nil {
+			return err
+		}
+		if len(oldElems) == len(currentElems) {
+			for i := range oldElems {
+				var oldAttrs, currentAttrs map[string]tftypes.Value
+				if err := oldElems[i].As(&oldAttrs); err != nil {
+					return err
+				}
+				if err := currentElems[i].As(&currentAttrs); err != nil {
+					return err
+				}
+				if err := d.attributes(append(slices.Clone(p), fmt.Sprint(i)), nested, oldAttrs, currentAttrs); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	c := change{path: strings.Join(p, ".")}
+	var equivalent func(a, b string) bool
+	switch {
+	case slices.Contains(d.json, c.path):
+		equivalent = utils.IsJSONEqual
+	case slices.Contains(d.durations, c.path):
+		equivalent = utils.IsDurationEqual
+	}
+	if equal(old, current, equivalent) {
+		return nil
+	}
+	if attr.IsSensitive() || slices.Contains(d.secrets, c.path) {
+		c.old, c.new = "(sensitive value)", "(sensitive value)"
+	} else {
+		var err error
+		if c.old, err = render(old); err != nil {
+			return fmt.Errorf("%s: %w", c.path, err)
+		}
+		if c.new, err = render(current); err != nil {
+			return fmt.Errorf("%s: %w", c.path, err)
+		}
+	}
+	d.changes = append(d.changes, c)
+	return nil
+}
+
+// equal compares two values, treating an empty string as equal to null like
+// the resources do when they read an object. If equivalent is set, strings
+// it reports as equivalent are equal too.
+func equal(a, b tftypes.Value, equivalent func(a, b string) bool) bool {
+	if a.Type().Is(tftypes.String) && b.Type().Is(tftypes.String) {
+		var aString, bString string
+		if !a.IsNull() {
+			_ = a.As(&aString)
+		}
+		if !b.IsNull() {
+			_ = b.As(&bString)
+		}
+		return aString == bString || (equivalent != nil && equivalent(aString, bString))
+	}
+	if a.IsNull() || b.IsNull() {
+		return a.IsNull() == b.IsNull()
+	}
+
+	typ := a.Type()
+	switch {
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Tuple{}):
+		var aElems, bElems []tftypes.Value
+		if a.As(&aElems) != nil || b.As(&bElems) != nil || len(aElems) != len(bElems) {
+			return false
+		}
+		for i := range aElems {
+			if !equal(aElems[i], bElems[i], equivalent) {
+				return false
+			}
+		}
+		return true
+	case typ.Is(tftypes.Set{}):
+		var aElems, bElems []tftypes.Value
+		if a.As(&aElems) != nil || b.As(&bElems) != nil || len(aElems) != len(bElems) {
+			return false
+		}
+		for _, aElem := range aElems {
+			if !slices.ContainsFunc(bElems, func(bElem tftypes.Value) bool { return equal(aElem, bElem, equivalent) }) {
+				return false
+			}
+		}
+		return true
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var aElems, bElems map[string]tftypes.Value
+		if a.As(&aElems) != nil || b.As(&bElems) != nil || len(aElems) != len(bElems) {
+			return false
+		}
+		for k, aElem := range aElems {
+			bElem, ok := bElems[k]
+			if !ok || !equal(aElem, bElem, equivalent) {
+				return false
+			}
+		}
+		return true
+	}
+	return a.Equal(b)
+}
+
+// render formats a value as JSON.
+func render(v tftypes.Value) (string, error) {
+	val, err := jsonValue(v)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func jsonValue(v tftypes.Value) (any, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		err := v.As(&n)
+		return json.Number(n.Text('g', -1)), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		vals := make([]any, len(elems))
+		for i, elem := range elems {
+			val, err := jsonValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = val
+		}
+		return vals, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		vals := map[string]any{}
+		for k, elem := range elems {
+			val, err := jsonValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			vals[k] = val
+		}
+		return vals, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// resourceAddress returns the address terraform uses for a resource
+// instance, e.g. module.pki.smallstep_authority.this["prod"].
+func resourceAddress(module, typeName, name string, indexKey any) string {
+	address := typeName + "." + name
+	if module != "" {
+		address = module + "." + address
+	}
+	switch key := indexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	case float64:
+		address += fmt.Sprintf("[%d]", int(key))
+	}
+	return address
+}
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrift(t *testing.T) {
+	client := newFakeAPI(t)
+	ctx := context.Background()
+
+	resp, err := client.PostAuthorities(ctx, &v20260501.PostAuthoritiesParams{}, v20260501.PostAuthoritiesJSONRequestBody{
+		Name:        "Drift Test",
+		AdminEmails: []string{"eng@example.com"},
+		Subdomain:   "drift",
+		Type:        "devops",
+	})
+	authority := decode[v20260501.Authority](t, resp, err)
+
+	p := v20260501.Provisioner{
+		Name:   "jwk",
+		Type:   v20260501.JWK,
+		Claims: &v20260501.ProvisionerClaims{MaxTLSCertDuration: &[]string{"24h"}[0]},
+	}
+	require.NoError(t, p.FromJwkProvisioner(v20260501.JwkProvisioner{
+		Key: map[string]any{"kty": "EC", "crv": "P-256", "x": "x", "y": "y"},
+	}))
+	resp, err = client.PostAuthorityProvisioners(ctx, authority.Id, &v20260501.PostAuthorityProvisionersParams{}, p)
+	decode[v20260501.Provisioner](t, resp, err)
+
+	s, err := newSession(ctx, "test")
+	require.NoError(t, err)
+
+	// Build a state file from what the resources read, as terraform would
+	// after importing the objects.
+	attributes := func(typeName, id string) map[string]any {
+		state, err := s.importResource(ctx, typeName, id)
+		require.NoError(t, err)
+		v, err := jsonValue(state.Raw)
+		require.NoError(t, err)
+		return v.(map[string]any)
+	}
+	authorityAttrs := attributes("smallstep_authority", authority.Id)
+	provisionerAttrs := attributes("smallstep_provisioner", authority.Id+"/jwk")
+	writeState := func() *bytes.Buffer {
+		b, err := json.Marshal(map[string]any{
+			"version": 4,
+			"resources": []map[string]any{
+				{
+					"mode": "managed", "type": "smallstep_authority", "name": "this",
+					"instances": []map[string]any{{"schema_version": 0, "attributes": authorityAttrs}},
+				},
+				{
+					"module": "module.pki", "mode": "managed", "type": "smallstep_provisioner", "name": "this",
+					"instances": []map[string]any{{"index_key": "jwk", "schema_version": 0, "attributes": provisionerAttrs}},
+				},
+				{
+					"mode": "data", "type": "smallstep_authority", "name": "this",
+					"instances": []map[string]any{{"schema_version": 0, "attributes": authorityAttrs}},
+				},
+			},
+		})
+		require.NoError(t, err)
+		return bytes.NewBuffer(b)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := Drift(ctx, "test", []string{"-state", "-"}, writeState(), &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+	assert.Equal(t, "No drift in 2 objects.\n", stdout.String())
+
+	// Equivalent durations and JSON are not drift.
+	provisionerAttrs["claims"].(map[string]any)["max_tls_cert_duration"] = "1440m"
+	provisionerAttrs["jwk"].(map[string]any)["key"] = `{"y":"y","x":"x","crv":"P-256","kty":"EC"}`
+	stdout.Reset()
+	code = Drift(ctx, "test", []string{"-state", "-"}, writeState(), &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+
+	authorityAttrs["name"] = "Old Name"
+	authorityAttrs["admin_emails"] = []string{"old@example.com"}
+	resp, err = client.DeleteProvisioner(ctx, authority.Id, "jwk", &v20260501.DeleteProvisionerParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	stdout.Reset()
+	code = Drift(ctx, "test", []string{"-state", "-"}, writeState(), &stdout, &stderr)
+	require.Equal(t, 2, code, stderr.String())
+	assert.Equal(t, strings.Join([]string{
+		"smallstep_authority.this has changed",
+		`  ~ admin_emails: ["old@example.com"] -> ["eng@example.com"]`,
+		`  ~ name: "Old Name" -> "Drift Test"`,
+		`module.pki.smallstep_provisioner.this["jwk"] has been deleted`,
+		"",
+		"2 of 2 objects drifted.",
+		"",
+	}, "\n"), stdout.String())
+}
+
+func TestDrift_stateVersion(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := Drift(context.Background(), "test", []string{"-state", "-"}, strings.NewReader(`{"version": 3}`), &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "drift: unsupported state file format version 3\n", stderr.String())
+}
+
+func TestDrift_flags(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := Drift(context.Background(), "test", []string{"-sate", "-"}, strings.NewReader(""), &stdout, &stderr)
+	assert.Equal(t, 1, code, "flag errors must not look like drift")
+	assert.Contains(t, stderr.String(), "flag provided but not defined: -sate")
+
+	stderr.Reset()
+	code = Drift(context.Background(), "test", []string{"-h"}, strings.NewReader(""), &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stderr.String(), "Usage: terraform-provider-smallstep drift")
+}
+
+func TestStateFromJSON_upgrade(t *testing.T) {
+	newFakeAPI(t)
+	ctx := context.Background()
+	s, err := newSession(ctx, "test")
+	require.NoError(t, err)
+	r, err := s.resource(ctx, "smallstep_credential")
+	require.NoError(t, err)
+
+	state, err := s.stateFromJSON(ctx, r, "smallstep_credential", 0, json.RawMessage(`{"id": "abc", "slug": "laptop"}`))
+	require.NoError(t, err)
+	var mode types.String
+	require.False(t, state.GetAttribute(ctx, path.Root("management_mode"), &mode).HasError())
+	assert.True(t, mode.IsNull())
+	var slug types.String
+	require.False(t, state.GetAttribute(ctx, path.Root("slug"), &slug).HasError())
+	assert.Equal(t, "laptop", slug.ValueString())
+
+	_, err = s.stateFromJSON(ctx, r, "smallstep_credential", 3, json.RawMessage(`{}`))
+	assert.ErrorContains(t, err, "supports up to 2")
+}
+
+func TestEqual(t *testing.T) {
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+	null := tftypes.NewValue(tftypes.String, nil)
+	set := func(elems ...tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+	}
+
+	tests := []struct {
+		name       string
+		a, b       tftypes.Value
+		equivalent func(a, b string) bool
+		want       bool
+	}{
+		{"same", str("a"), str("a"), nil, true},
+		{"different", str("a"), str("b"), nil, false},
+		{"duration", str("24h"), str("1440m0s"), utils.IsDurationEqual, true},
+		{"different duration", str("24h"), str("25h"), utils.IsDurationEqual, false},
+		{"duration string", str("24h"), str("1440m0s"), nil, false},
+		{"json", str(`{"a": 1, "b": [true]}`), str(`{"b":[true],"a":1}`), utils.IsJSONEqual, true},
+		{"different json", str(`{"a": 1}`), str(`{"a": 2}`), utils.IsJSONEqual, false},
+		{"json number string", str("1"), str("1.0"), nil, false},
+		{"json bool string", str(`"true"`), str("true"), nil, false},
+		{"empty and null", str(""), null, nil, true},
+		{"null", str("a"), null, nil, false},
+		{"set order", set(str("a"), str("b")), set(str("b"), str("a")), nil, true},
+		{"set elements", set(str("a")), set(str("a"), str("b")), nil, false},
+		{"set durations", set(str("1h")), set(str("60m")), utils.IsDurationEqual, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, equal(tc.a, tc.b, tc.equivalent))
+			assert.Equal(t, tc.want, equal(tc.b, tc.a, tc.equivalent))
+		})
+	}
+}
+
+func TestDiffer_equivalent(t *testing.T) {
+	attr := schema.StringAttribute{Optional: true}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	// Only attributes known to hold JSON or durations are compared by value.
+	d := differ{json: []string{"data"}, durations: []string{"ttl"}}
+	require.NoError(t, d.attribute([]string{"data"}, attr, str("1"), str("1.0")))
+	require.NoError(t, d.attribute([]string{"ttl"}, attr, str("1h"), str("60m")))
+	assert.Empty(t, d.changes)
+
+	require.NoError(t, d.attribute([]string{"name"}, attr, str("1"), str("1.0")))
+	require.NoError(t, d.attribute([]string{"data"}, attr, str("1h"), str("60m")))
+	assert.Equal(t, []change{
+		{path: "name", old: `"1"`, new: `"1.0"`},
+		{path: "data", old: `"1h"`, new: `"60m"`},
+	}, d.changes)
+}
+
+func TestResourceAddress(t *testing.T) {
+	assert.Equal(t, "smallstep_authority.this", resourceAddress("", "smallstep_authority", "this", nil))
+	assert.Equal(t, "smallstep_authority.this[0]", resourceAddress("", "smallstep_authority", "this", float64(0)))
+	assert.Equal(t, `module.pki.smallstep_authority.this["prod"]`, resourceAddress("module.pki", "smallstep_authority", "this", "prod"))
+}
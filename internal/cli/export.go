@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/pagination"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+)
+
+// exported is an object to export, with the ID its resource imports it by.
+type exported struct {
+	importID string
+	name     string
+}
+
+// exporter lists the objects of one resource type.
+type exporter struct {
+	typeName string
+	list     func(ctx context.Context, client *v20260501.Client) ([]exported, error)
+}
+
+// exporters are in dependency order, so references to exported objects can be
+// used in place of their IDs.
+var exporters = []exporter{
+	{"smallstep_authority", listAuthorities},
+	{"smallstep_provisioner", listProvisioners},
+	{"smallstep_provisioner_webhook", listWebhooks},
+	{"smallstep_credential", listCredentials},
+	{"smallstep_wifi", listWifi},
+	{"smallstep_vpn", listVPNs},
+	{"smallstep_ethernet", listEthernet},
+	{"smallstep_device", listDevices},
+	{"smallstep_managed_radius", listManagedRadius},
+}
+
+const exportUsage = `Usage: terraform-provider-smallstep export [options]
+
+Writes the configuration of the team's authorities, provisioners, webhooks,
+credentials, Wi-Fi, VPN and ethernet configurations, devices and managed RADIUS
+servers as resource blocks with import blocks, so that "terraform plan" imports
+them. Secrets are replaced with variables.
+
+The API URL, credentials and API version are read from the same environment
+variables as the provider, e.g. SMALLSTEP_API_TOKEN, or from the step CLI
+context set with SMALLSTEP_CONTEXT.
+
+Options:
+`
+
+// Export runs the export subcommand and returns the process exit code.
+func Export(ctx context.Context, version string, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	out := flags.String("out", "", "write the configuration to this file instead of stdout")
+	types := flags.String("types", "", "comma separated resource types to export, e.g. smallstep_authority,smallstep_provisioner. Defaults to all.")
+	flags.Usage = func() {
+		fmt.Fprint(stderr, exportUsage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	var only []string
+	if *types != "" {
+		only = strings.Split(*types, ",")
+		for _, typeName := range only {
+			if !slices.ContainsFunc(exporters, func(e exporter) bool { return e.typeName == typeName }) {
+				fmt.Fprintf(stderr, "export: unsupported resource type %q\n", typeName)
+				return 2
+			}
+		}
+	}
+
+	s, err := newSession(ctx, version)
+	if err != nil {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+		return 1
+	}
+
+	w := newConfigWriter()
+	errs := export(ctx, s, w, only)
+	for _, err := range errs {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+	}
+
+	if *out == "" {
+		_, err = stdout.Write(w.Bytes())
+	} else {
+		err = os.WriteFile(*out, w.Bytes(), 0600)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+		return 1
+	}
+	if len(errs) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// export writes every object of the exported types. Objects that fail to
+// export are reported and skipped.
+func export(ctx context.Context, s *session, w *configWriter, only []string) []error {
+	var errs []error
+	for _, e := range exporters {
+		if len(only) > 0 && !slices.Contains(only, e.typeName) {
+			continue
+		}
+		objects, err := e.list(ctx, s.clients.Pinned)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, obj := range objects {
+			state, err := s.importResource(ctx, e.typeName, obj.importID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if state.Raw.IsNull() {
+				// Deleted since it was listed.
+				continue
+			}
+			if err := w.addResource(ctx, e.typeName, obj.name, obj.importID, state); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+func authorities(ctx context.Context, client *v20260501.Client) ([]v20260501.Authority, error) {
+	return pagination.List[v20260501.Authority]("authorities", func() (*http.Response, error) {
+		return client.GetAuthorities(ctx, &v20260501.GetAuthoritiesParams{})
+	})
+}
+
+func listAuthorities(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	list, err := authorities(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	var objects []exported
+	for _, authority := range list {
+		objects = append(objects, exported{importID: authority.Id, name: authority.Name})
+	}
+	return objects, nil
+}
+
+// provisioners calls fn with every provisioner of every authority.
+func provisioners(ctx context.Context, client *v20260501.Client, fn func(authority v20260501.Authority, provisioner v20260501.Provisioner)) error {
+	list, err := authorities(ctx, client)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, authority := range list {
+		provisioners, err := pagination.List[v20260501.Provisioner]("provisioners", func() (*http.Response, error) {
+			return client.ListAuthorityProvisioners(ctx, authority.Id, &v20260501.ListAuthorityProvisionersParams{})
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("authority %s: %w", authority.Domain, err))
+			continue
+		}
+		for _, provisioner := range provisioners {
+			fn(authority, provisioner)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func listProvisioners(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	var objects []exported
+	err := provisioners(ctx, client, func(authority v20260501.Authority, provisioner v20260501.Provisioner) {
+		objects = append(objects, exported{
+			importID: authority.Id + "/" + provisioner.Name,
+			name:     provisioner.Name,
+		})
+	})
+	return objects, err
+}
+
+func listWebhooks(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	var objects []exported
+	err := provisioners(ctx, client, func(authority v20260501.Authority, provisioner v20260501.Provisioner) {
+		if provisioner.Options == nil || provisioner.Options.Webhooks == nil {
+			return
+		}
+		for _, webhook := range *provisioner.Options.Webhooks {
+			objects = append(objects, exported{
+				importID: authority.Id + "/" + utils.Deref(provisioner.Id) + "/" + webhook.Name,
+				name:     provisioner.Name + "_" + webhook.Name,
+			})
+		}
+	})
+	return objects, err
+}
+
+func listCredentials(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	list, err := pagination.All[v20260501.Credential]("credentials", func(after *string) (*http.Response, error) {
+		return client.ListCredentials(ctx, &v20260501.ListCredentialsParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	var objects []exported
+	for _, credential := range list {
+		objects = append(objects, exported{importID: utils.Deref(credential.Id), name: credential.Slug})
+	}
+	return objects, err
+}
+
+func listWifi(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	list, err := pagination.All[v20260501.Wifi]("wifi", func(after *string) (*http.Response, error) {
+		return client.ListWifi(ctx, &v20260501.ListWifiParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	var objects []exported
+	for _, wifi := range list {
+		objects = append(objects, exported{importID: utils.Deref(wifi.Id), name: utils.Deref(wifi.Name)})
+	}
+	return objects, err
+}
+
+func listVPNs(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	list, err := pagination.All[v20260501.Vpn]("vpn", func(after *string) (*http.Response, error) {
+		return client.ListVpn(ctx, &v20260501.ListVpnParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	var objects []exported
+	for _, vpn := range list {
+		objects = append(objects, exported{importID: utils.Deref(vpn.Id), name: utils.Deref(vpn.Name)})
+	}
+	return objects, err
+}
+
+func listEthernet(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	list, err := pagination.All[v20260501.Ethernet]("ethernet", func(after *string) (*http.Response, error) {
+		return client.ListEthernet(ctx, &v20260501.ListEthernetParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	var objects []exported
+	for _, ethernet := range list {
+		objects = append(objects, exported{importID: utils.Deref(ethernet.Id), name: utils.Deref(ethernet.Name)})
+	}
+	return objects, err
+}
+
+func listDevices(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	list, err := pagination.All[v20260501.Device]("devices", func(after *string) (*http.Response, error) {
+		return client.ListDevices(ctx, &v20260501.ListDevicesParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	var objects []exported
+	for _, device := range list {
+		name := utils.Deref(device.DisplayName)
+		if name == "" {
+			name = device.PermanentIdentifier
+		}
+		objects = append(objects, exported{importID: device.Id, name: name})
+	}
+	return objects, err
+}
+
+func listManagedRadius(ctx context.Context, client *v20260501.Client) ([]exported, error) {
+	list, err := pagination.List[v20260501.ManagedRadius]("managed radius", func() (*http.Response, error) {
+		return client.ListManagedRadius(ctx, &v20260501.ListManagedRadiusParams{})
+	})
+	var objects []exported
+	for _, radius := range list {
+		objects = append(objects, exported{importID: utils.Deref(radius.Id), name: radius.Name})
+	}
+	return objects, err
+}
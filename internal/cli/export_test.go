@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/fakeapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeAPI starts a fake API the provider is configured to use through the
+// environment, and returns a client for it.
+func newFakeAPI(t *testing.T) *v20260501.Client {
+	srv := fakeapi.New()
+	t.Cleanup(srv.Close)
+	// Lists of more than one object take more than one page.
+	srv.SetPageSize(1)
+	t.Setenv("SMALLSTEP_API_URL", srv.URL)
+	t.Setenv("SMALLSTEP_API_TOKEN", fakeapi.Token)
+	t.Setenv("SMALLSTEP_API_VERSION", "")
+
+	client, err := v20260501.NewClient(srv.URL, v20260501.WithRequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", "2026-05-01")
+		r.Header.Set("Authorization", "Bearer "+fakeapi.Token)
+		return nil
+	}))
+	require.NoError(t, err)
+	return client
+}
+
+func decode[T any](t *testing.T, resp *http.Response, err error) *T {
+	t.Helper()
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Less(t, resp.StatusCode, 300, string(body))
+	v := new(T)
+	require.NoError(t, json.Unmarshal(body, v))
+	return v
+}
+
+func TestExport(t *testing.T) {
+	client := newFakeAPI(t)
+	ctx := context.Background()
+
+	resp, err := client.PostAuthorities(ctx, &v20260501.PostAuthoritiesParams{}, v20260501.PostAuthoritiesJSONRequestBody{
+		Name:        "Export Test",
+		AdminEmails: []string{"eng@example.com"},
+		Subdomain:   "export",
+		Type:        "devops",
+	})
+	authority := decode[v20260501.Authority](t, resp, err)
+
+	p := v20260501.Provisioner{Name: "Google SSO", Type: v20260501.OIDC}
+	require.NoError(t, p.FromOidcProvisioner(v20260501.OidcProvisioner{
+		ClientID:              "client-id",
+		ClientSecret:          "client-secret",
+		ConfigurationEndpoint: "https://accounts.google.com/.well-known/openid-configuration",
+	}))
+	resp, err = client.PostAuthorityProvisioners(ctx, authority.Id, &v20260501.PostAuthorityProvisionersParams{}, p)
+	decode[v20260501.Provisioner](t, resp, err)
+
+	var stdout, stderr bytes.Buffer
+	code := Export(ctx, "test", []string{"-types", "smallstep_authority,smallstep_provisioner"}, &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+	assert.NotContains(t, stdout.String(), "client-secret")
+
+	file, diags := hclsyntax.ParseConfig(stdout.Bytes(), "export.tf", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+	body := file.Body.(*hclsyntax.Body)
+
+	blocks := map[string][]*hclsyntax.Block{}
+	for _, block := range body.Blocks {
+		blocks[block.Type] = append(blocks[block.Type], block)
+	}
+
+	require.Len(t, blocks["import"], 2)
+	var ids []string
+	for _, block := range blocks["import"] {
+		v, diags := block.Body.Attributes["id"].Expr.Value(nil)
+		require.False(t, diags.HasErrors(), diags.Error())
+		ids = append(ids, v.AsString())
+	}
+	assert.Equal(t, []string{authority.Id, authority.Id + "/Google SSO"}, ids)
+
+	require.Len(t, blocks["resource"], 2)
+	assert.Equal(t, []string{"smallstep_authority", "export_test"}, blocks["resource"][0].Labels)
+	assert.Equal(t, []string{"smallstep_provisioner", "google_sso"}, blocks["resource"][1].Labels)
+
+	subdomain, diags := blocks["resource"][0].Body.Attributes["subdomain"].Expr.Value(nil)
+	require.False(t, diags.HasErrors(), diags.Error())
+	assert.Equal(t, "export", subdomain.AsString())
+
+	provisioner := blocks["resource"][1].Body
+	refs := provisioner.Attributes["authority_id"].Expr.Variables()
+	require.Len(t, refs, 1)
+	assert.Equal(t, "smallstep_authority.export_test.id", hclTraversal(refs[0]))
+
+	oidc := provisioner.Attributes["oidc"].Expr.(*hclsyntax.ObjectConsExpr)
+	var secret hcl.Expression
+	for _, item := range oidc.Items {
+		key, diags := item.KeyExpr.Value(nil)
+		require.False(t, diags.HasErrors(), diags.Error())
+		if key.AsString() == "client_secret" {
+			secret = item.ValueExpr
+		}
+	}
+	require.NotNil(t, secret)
+	refs = secret.Variables()
+	require.Len(t, refs, 1)
+	assert.Equal(t, "var.smallstep_provisioner_google_sso_oidc_client_secret", hclTraversal(refs[0]))
+
+	variables := map[string]*hclsyntax.Body{}
+	for _, block := range blocks["variable"] {
+		variables[block.Labels[0]] = block.Body
+	}
+	require.Contains(t, variables, "smallstep_provisioner_google_sso_oidc_client_secret")
+	sensitive, diags := variables["smallstep_provisioner_google_sso_oidc_client_secret"].Attributes["sensitive"].Expr.Value(nil)
+	require.False(t, diags.HasErrors(), diags.Error())
+	assert.True(t, sensitive.True())
+}
+
+func TestExport_unsupportedType(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := Export(context.Background(), "test", []string{"-types", "smallstep_browser"}, &stdout, &stderr)
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr.String(), `unsupported resource type "smallstep_browser"`)
+}
+
+func hclTraversal(traversal hcl.Traversal) string {
+	var b strings.Builder
+	for i, step := range traversal {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			b.WriteString(step.Name)
+		case hcl.TraverseAttr:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(step.Name)
+		}
+	}
+	return b.String()
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"Google SSO":          "google_sso",
+		"ca.example.com":      "ca_example_com",
+		"--hook--":            "hook",
+		"1st":                 "_1st",
+		"":                    "_",
+		"Wi-Fi: Office (5G)!": "wi_fi_office_5g",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, identifier(name), name)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	used := map[string]bool{}
+	assert.Equal(t, "a", unique("a", used))
+	assert.Equal(t, "a_2", unique("a", used))
+	assert.Equal(t, "a_3", unique("a", used))
+	assert.Equal(t, "b", unique("b", used))
+}
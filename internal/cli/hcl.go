@@ -0,0 +1,364 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// secretAttributes are the attributes with secrets that aren't marked
+// sensitive in the resource schemas, by resource type.
+var secretAttributes = map[string][]string{
+	"smallstep_provisioner": {"jwk.encrypted_key", "oidc.client_secret"},
+}
+
+// configWriter renders objects as resource blocks with import blocks, and
+// declares a variable for every secret and for required attributes the API
+// doesn't return.
+type configWriter struct {
+	resources *hclwrite.File
+	variables *hclwrite.File
+	// labels are the resource labels used so far, by resource type.
+	labels map[string]map[string]bool
+	// varNames are the variable names used so far.
+	varNames map[string]bool
+	// refs are references to the id attribute of each exported object, by
+	// the object's ID.
+	refs map[string]hcl.Traversal
+}
+
+func newConfigWriter() *configWriter {
+	return &configWriter{
+		resources: hclwrite.NewEmptyFile(),
+		variables: hclwrite.NewEmptyFile(),
+		labels:    map[string]map[string]bool{},
+		varNames:  map[string]bool{},
+		refs:      map[string]hcl.Traversal{},
+	}
+}
+
+// Bytes returns the formatted configuration with the variables first.
+func (w *configWriter) Bytes() []byte {
+	return hclwrite.Format(append(w.variables.Bytes(), w.resources.Bytes()...))
+}
+
+// addResource writes the resource and import blocks of an object read with
+// the resource's Read. The label is made unique among the resources of the
+// type.
+func (w *configWriter) addResource(ctx context.Context, typeName, name, importID string, state tfsdk.State) error {
+	label := w.label(typeName, name)
+
+	body := w.resources.Body()
+	imp := body.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: label},
+	})
+	imp.SetAttributeValue("id", cty.StringVal(importID))
+	body.AppendNewline()
+
+	var values map[string]tftypes.Value
+	if err := state.Raw.As(&values); err != nil {
+		return fmt.Errorf("%s %q: %w", typeName, importID, err)
+	}
+
+	sch, ok := state.Schema.(schema.Schema)
+	if !ok {
+		return fmt.Errorf("%s: unexpected schema %T", typeName, state.Schema)
+	}
+
+	o := object{
+		w:        w,
+		typeName: typeName,
+		label:    label,
+		secrets:  secretAttributes[typeName],
+	}
+	block := body.AppendNewBlock("resource", []string{typeName, label}).Body()
+	attrs, err := o.attributes(ctx, nil, sch.Attributes, values)
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", typeName, importID, err)
+	}
+	for _, attr := range attrs {
+		block.SetAttributeRaw(attr.name, attr.tokens)
+	}
+	body.AppendNewline()
+
+	if id, ok := values["id"]; ok && id.IsKnown() && !id.IsNull() {
+		var s string
+		if err := id.As(&s); err == nil && s != "" {
+			w.refs[s] = hcl.Traversal{
+				hcl.TraverseRoot{Name: typeName},
+				hcl.TraverseAttr{Name: label},
+				hcl.TraverseAttr{Name: "id"},
+			}
+		}
+	}
+	return nil
+}
+
+// label returns a unique resource label based on the object's name.
+func (w *configWriter) label(typeName, name string) string {
+	if w.labels[typeName] == nil {
+		w.labels[typeName] = map[string]bool{}
+	}
+	return unique(identifier(name), w.labels[typeName])
+}
+
+// variable declares a variable for an attribute and returns a reference to
+// it.
+func (w *configWriter) variable(ctx context.Context, name string, attr schema.Attribute, sensitive bool) hclwrite.Tokens {
+	name = unique(identifier(name), w.varNames)
+
+	body := w.variables.Body()
+	block := body.AppendNewBlock("variable", []string{name}).Body()
+	block.SetAttributeRaw("type", typeTokens(attr.GetType().TerraformType(ctx)))
+	if sensitive {
+		block.SetAttributeValue("sensitive", cty.True)
+	}
+	body.AppendNewline()
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+type attribute struct {
+	name   string
+	tokens hclwrite.Tokens
+}
+
+// object renders the attributes of one resource.
+type object struct {
+	w        *configWriter
+	typeName string
+	label    string
+	secrets  []string
+}
+
+// attributes renders the attributes that can be configured, sorted by name.
+// Attributes that are null or only computed are left out.
+func (o object) attributes(ctx context.Context, parent []string, attrs map[string]schema.Attribute, values map[string]tftypes.Value) ([]attribute, error) {
+	var names []string
+	for name := range attrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var rendered []attribute
+	for _, name := range names {
+		attr := attrs[name]
+		value, ok := values[name]
+		if !ok || !value.IsKnown() {
+			continue
+		}
+		if attr.IsComputed() && !attr.IsOptional() && !attr.IsRequired() {
+			continue
+		}
+		p := append(slices.Clone(parent), name)
+
+		var tokens hclwrite.Tokens
+		var err error
+		switch {
+		case value.IsNull() && attr.IsRequired():
+			// Write-only attributes the API doesn't return.
+			tokens = o.w.variable(ctx, o.varName(p), attr, false)
+		case value.IsNull():
+			continue
+		case attr.IsSensitive() || slices.Contains(o.secrets, strings.Join(p, ".")):
+			tokens = o.w.variable(ctx, o.varName(p), attr, true)
+		default:
+			tokens, err = o.value(ctx, p, attr, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		rendered = append(rendered, attribute{name: name, tokens: tokens})
+	}
+	return rendered, nil
+}
+
+func (o object) value(ctx context.Context, p []string, attr schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	switch attr := attr.(type) {
+	case schema.SingleNestedAttribute:
+		return o.nested(ctx, p, attr.Attributes, value)
+	case schema.ListNestedAttribute:
+		return o.nestedList(ctx, p, attr.NestedObject.Attributes, value)
+	case schema.SetNestedAttribute:
+		return o.nestedList(ctx, p, attr.NestedObject.Attributes, value)
+	}
+
+	// References to other exported objects keep their dependencies.
+	if value.Type().Is(tftypes.String) && strings.HasSuffix(p[len(p)-1], "_id") {
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+		if ref, ok := o.w.refs[s]; ok {
+			return hclwrite.TokensForTraversal(ref), nil
+		}
+	}
+
+	v, err := ctyValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", strings.Join(p, "."), err)
+	}
+	return hclwrite.TokensForValue(v), nil
+}
+
+func (o object) nested(ctx context.Context, p []string, attrs map[string]schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return nil, err
+	}
+	rendered, err := o.attributes(ctx, p, attrs, values)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []hclwrite.ObjectAttrTokens
+	for _, attr := range rendered {
+		tokens = append(tokens, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(attr.name),
+			Value: attr.tokens,
+		})
+	}
+	return hclwrite.TokensForObject(tokens), nil
+}
+
+func (o object) nestedList(ctx context.Context, p []string, attrs map[string]schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	var elems []tftypes.Value
+	if err := value.As(&elems); err != nil {
+		return nil, err
+	}
+	var tokens []hclwrite.Tokens
+	for i, elem := range elems {
+		t, err := o.nested(ctx, append(slices.Clone(p), fmt.Sprint(i)), attrs, elem)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+	return hclwrite.TokensForTuple(tokens), nil
+}
+
+func (o object) varName(p []string) string {
+	return o.typeName + "_" + o.label + "_" + strings.Join(p, "_")
+}
+
+// ctyValue converts a value of any type the resources use.
+func ctyValue(v tftypes.Value) (cty.Value, error) {
+	if v.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return cty.StringVal(s), err
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		err := v.As(&n)
+		return cty.NumberVal(n), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return cty.BoolVal(b), err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elems) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		vals := make([]cty.Value, len(elems))
+		for i, elem := range elems {
+			val, err := ctyValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals[i] = val
+		}
+		return cty.TupleVal(vals), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elems) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		vals := map[string]cty.Value{}
+		for k, elem := range elems {
+			val, err := ctyValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals[k] = val
+		}
+		return cty.ObjectVal(vals), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported type %s", typ)
+}
+
+// typeTokens renders a variable type constraint.
+func typeTokens(typ tftypes.Type) hclwrite.Tokens {
+	switch t := typ.(type) {
+	case tftypes.List:
+		return hclwrite.TokensForFunctionCall("list", typeTokens(t.ElementType))
+	case tftypes.Set:
+		return hclwrite.TokensForFunctionCall("set", typeTokens(t.ElementType))
+	case tftypes.Map:
+		return hclwrite.TokensForFunctionCall("map", typeTokens(t.ElementType))
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		return hclwrite.TokensForIdentifier("string")
+	case typ.Is(tftypes.Number):
+		return hclwrite.TokensForIdentifier("number")
+	case typ.Is(tftypes.Bool):
+		return hclwrite.TokensForIdentifier("bool")
+	}
+	return hclwrite.TokensForIdentifier("any")
+}
+
+// identifier turns a name into a valid HCL identifier.
+func identifier(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+			underscore = false
+		} else if !underscore {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	id := strings.Trim(b.String(), "_")
+	if id == "" || id[0] >= '0' && id[0] <= '9' {
+		id = "_" + id
+	}
+	return id
+}
+
+// unique returns name, or name with a numeric suffix if it's already used,
+// and records it as used.
+func unique(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[candidate] = true
+	return candidate
+}
@@ -0,0 +1,194 @@
+// Package cli implements the provider binary's subcommands for working with a
+// team's configuration outside of terraform.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+)
+
+// session is a configured provider and the resources it serves.
+type session struct {
+	clients   *clientset.Clients
+	resources map[string]func() resource.Resource
+	// schemas caches the schema of each resource type. The schemas get their
+	// descriptions from the API spec, which is slow to parse.
+	schemas map[string]schema.Schema
+}
+
+// newSession configures the provider as terraform would with an empty
+// provider block, so the API URL, credentials and API version are read from
+// the same environment variables and step context.
+func newSession(ctx context.Context, version string) (*session, error) {
+	p := provider.New(version)()
+
+	schemaResp := &fwprovider.SchemaResponse{}
+	p.Schema(ctx, fwprovider.SchemaRequest{}, schemaResp)
+	if err := diagnosticsErr("provider schema", schemaResp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+	for name, t := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(t, nil)
+	}
+
+	configureResp := &fwprovider.ConfigureResponse{}
+	p.Configure(ctx, fwprovider.ConfigureRequest{
+		TerraformVersion: "none",
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(typ, attrs),
+		},
+	}, configureResp)
+	if err := diagnosticsErr("configure provider", configureResp.Diagnostics); err != nil {
+		return nil, err
+	}
+	clients, ok := configureResp.ResourceData.(*clientset.Clients)
+	if !ok {
+		return nil, fmt.Errorf("configure provider: unexpected resource data %T", configureResp.ResourceData)
+	}
+
+	s := &session{
+		clients:   clients,
+		resources: map[string]func() resource.Resource{},
+		schemas:   map[string]schema.Schema{},
+	}
+	for _, factory := range p.Resources(ctx) {
+		metaResp := &resource.MetadataResponse{}
+		factory().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "smallstep"}, metaResp)
+		s.resources[metaResp.TypeName] = factory
+	}
+	return s, nil
+}
+
+// resource returns a configured resource of the type.
+func (s *session) resource(ctx context.Context, typeName string) (resource.Resource, error) {
+	factory, ok := s.resources[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %s", typeName)
+	}
+	r := factory()
+	if rc, ok := r.(resource.ResourceWithConfigure); ok {
+		resp := &resource.ConfigureResponse{}
+		rc.Configure(ctx, resource.ConfigureRequest{ProviderData: s.clients}, resp)
+		if err := diagnosticsErr("configure "+typeName, resp.Diagnostics); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// importResource reads an object with the resource's ImportState and Read, as
+// `terraform import` does. The state is null if the object doesn't exist.
+func (s *session) importResource(ctx context.Context, typeName, id string) (tfsdk.State, error) {
+	r, err := s.resource(ctx, typeName)
+	if err != nil {
+		return tfsdk.State{}, err
+	}
+	rs, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		return tfsdk.State{}, fmt.Errorf("%s does not support import", typeName)
+	}
+
+	state := s.nullState(ctx, r, typeName)
+	importResp := &resource.ImportStateResponse{State: state}
+	rs.ImportState(ctx, resource.ImportStateRequest{ID: id}, importResp)
+	if err := diagnosticsErr(fmt.Sprintf("import %s %q", typeName, id), importResp.Diagnostics); err != nil {
+		return tfsdk.State{}, err
+	}
+
+	return s.read(ctx, r, typeName, importResp.State)
+}
+
+// stateFromJSON decodes the attributes of a resource instance in a state file,
+// upgrading them to the resource's current schema version as terraform does.
+func (s *session) stateFromJSON(ctx context.Context, r resource.Resource, typeName string, version int64, attributes json.RawMessage) (tfsdk.State, error) {
+	state := s.nullState(ctx, r, typeName)
+	raw := &tfprotov6.RawState{JSON: attributes}
+	// Attributes removed from the schema are ignored, like the framework does.
+	opts := tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	}
+
+	current := state.Schema.GetVersion()
+	switch {
+	case version == current:
+		v, err := raw.UnmarshalWithOpts(state.Schema.Type().TerraformType(ctx), opts)
+		if err != nil {
+			return tfsdk.State{}, fmt.Errorf("decode %s state: %w", typeName, err)
+		}
+		state.Raw = v
+		return state, nil
+	case version > current:
+		return tfsdk.State{}, fmt.Errorf("%s state has schema version %d, this provider supports up to %d", typeName, version, current)
+	}
+
+	var upgraders map[int64]resource.StateUpgrader
+	if ru, ok := r.(resource.ResourceWithUpgradeState); ok {
+		upgraders = ru.UpgradeState(ctx)
+	}
+	upgrader, ok := upgraders[version]
+	if !ok {
+		return tfsdk.State{}, fmt.Errorf("%s state has schema version %d, which can't be upgraded", typeName, version)
+	}
+
+	req := resource.UpgradeStateRequest{RawState: raw}
+	if upgrader.PriorSchema != nil {
+		v, err := raw.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), opts)
+		if err != nil {
+			return tfsdk.State{}, fmt.Errorf("decode %s state: %w", typeName, err)
+		}
+		req.State = &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: v}
+	}
+	resp := &resource.UpgradeStateResponse{State: state}
+	upgrader.StateUpgrader(ctx, req, resp)
+	if err := diagnosticsErr(fmt.Sprintf("upgrade %s state from version %d", typeName, version), resp.Diagnostics); err != nil {
+		return tfsdk.State{}, err
+	}
+	return resp.State, nil
+}
+
+func (s *session) read(ctx context.Context, r resource.Resource, typeName string, state tfsdk.State) (tfsdk.State, error) {
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if err := diagnosticsErr("read "+typeName, readResp.Diagnostics); err != nil {
+		return tfsdk.State{}, err
+	}
+	return readResp.State, nil
+}
+
+// nullState returns the state of a resource that doesn't exist yet.
+func (s *session) nullState(ctx context.Context, r resource.Resource, typeName string) tfsdk.State {
+	sch, ok := s.schemas[typeName]
+	if !ok {
+		resp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, resp)
+		sch = resp.Schema
+		s.schemas[typeName] = sch
+	}
+	return tfsdk.State{
+		Schema: sch,
+		Raw:    tftypes.NewValue(sch.Type().TerraformType(ctx), nil),
+	}
+}
+
+func diagnosticsErr(what string, diags diag.Diagnostics) error {
+	if err := utils.DiagnosticsToErr(diags); err != nil {
+		return fmt.Errorf("%s: %w", what, err)
+	}
+	return nil
+}
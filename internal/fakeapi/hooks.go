@@ -0,0 +1,166 @@
+package fakeapi
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.step.sm/crypto/minica"
+	"go.step.sm/crypto/randutil"
+)
+
+// createHooks set the properties the API computes when an object is created,
+// keyed by operation ID.
+var createHooks = map[string]func(s *Server, r *http.Request, obj map[string]any) error{
+	"PostAuthorities": func(s *Server, r *http.Request, obj map[string]any) error {
+		caDomain := os.Getenv("SMALLSTEP_CA_DOMAIN")
+		if caDomain == "" {
+			caDomain = ".step-e2e.ca.smallstep.com"
+		}
+		obj["domain"] = fmt.Sprint(obj["subdomain"]) + caDomain
+		for _, a := range s.objects["authorities"] {
+			if a["domain"] == obj["domain"] {
+				return fmt.Errorf("subdomain %q is already in use", obj["subdomain"])
+			}
+		}
+		root, err := newRoot()
+		if err != nil {
+			return err
+		}
+		block, _ := pem.Decode([]byte(root))
+		sum := sha256.Sum256(block.Bytes)
+		obj["root"] = root
+		obj["fingerprint"] = hex.EncodeToString(sum[:])
+		return nil
+	},
+	"PostDevices": func(s *Server, r *http.Request, obj map[string]any) error {
+		obj["hostID"] = uuid.NewString()
+		return nil
+	},
+	"PostIdpClients": func(s *Server, r *http.Request, obj map[string]any) error {
+		return setSecret(obj)
+	},
+	"PostManagedRadius": func(s *Server, r *http.Request, obj map[string]any) error {
+		root, err := newRoot()
+		if err != nil {
+			return err
+		}
+		obj["serverCA"] = root
+		obj["serverIP"] = fmt.Sprintf("10.%d.%d.%d", rand.IntN(256), rand.IntN(256), rand.IntN(255)+1)
+		obj["serverPort"] = "1812"
+		obj["serverHostname"] = fmt.Sprintf("radius.%s.smallstep.com", strings.Split(fmt.Sprint(obj["id"]), "-")[0])
+		return setSecret(obj)
+	},
+	"PostSsoIntegrations": func(s *Server, r *http.Request, obj map[string]any) error {
+		return setSecret(obj)
+	},
+	"PostWebhooks": func(s *Server, r *http.Request, obj map[string]any) error {
+		switch obj["serverType"] {
+		case "EXTERNAL":
+			return setSecret(obj)
+		case "HOSTED_ATTESTATION":
+			obj["url"] = fmt.Sprintf("https://webhooks.smallstep.com/%s/enrich/attested", obj["id"])
+		}
+		return nil
+	},
+}
+
+// writeHooks normalize objects the way the API does when they are created or
+// updated, keyed by operation ID.
+var writeHooks = map[string]func(obj map[string]any) error{
+	"PostCredentials":   normalizeCredential,
+	"PutCredential":     normalizeCredential,
+	"PostManagedRadius": omitEmptyReplyAttributes,
+	"PutManagedRadius":  omitEmptyReplyAttributes,
+	"PutIdentityProvider": func(obj map[string]any) error {
+		const issuer = "https://fakeapi.id.smallstep.com"
+		for name, value := range map[string]string{
+			"issuer":            issuer,
+			"authorizeEndpoint": issuer + "/authorize",
+			"tokenEndpoint":     issuer + "/token",
+			"jwksEndpoint":      issuer + "/keys",
+		} {
+			if _, ok := obj[name]; !ok {
+				obj[name] = value
+			}
+		}
+		return nil
+	},
+}
+
+func runWriteHook(rt *route, obj map[string]any) error {
+	if hook, ok := writeHooks[rt.Operation.OperationID]; ok {
+		return hook(obj)
+	}
+	return nil
+}
+
+// normalizeCredential sets the default certificate duration of 24 hours and
+// formats the duration the way Go does, e.g. 168h as 168h0m0s.
+func normalizeCredential(obj map[string]any) error {
+	cert, ok := obj["certificate"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	d := 24 * time.Hour
+	if s, ok := cert["duration"].(string); ok && s != "" {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return fmt.Errorf("invalid certificate duration %q: %w", s, err)
+		}
+	}
+	cert["duration"] = d.String()
+	return nil
+}
+
+// omitEmptyReplyAttributes removes an empty list of reply attributes, which the
+// API doesn't return.
+func omitEmptyReplyAttributes(obj map[string]any) error {
+	if attrs, ok := obj["replyAttributes"].([]any); ok && len(attrs) == 0 {
+		delete(obj, "replyAttributes")
+	}
+	return nil
+}
+
+// readHooks change a copy of an object before it is returned, keyed by
+// operation ID.
+var readHooks = map[string]func(r *http.Request, obj map[string]any) map[string]any{
+	"GetManagedRadius":  managedRadiusSecret,
+	"ListManagedRadius": managedRadiusSecret,
+	"PutManagedRadius":  managedRadiusSecret,
+	"PostManagedRadius": managedRadiusSecret,
+}
+
+// managedRadiusSecret removes the secret unless it was requested with the
+// secret query parameter.
+func managedRadiusSecret(r *http.Request, obj map[string]any) map[string]any {
+	if r.Method != http.MethodGet || r.URL.Query().Get("secret") != "true" {
+		delete(obj, "secret")
+	}
+	return obj
+}
+
+func setSecret(obj map[string]any) error {
+	b, err := randutil.Bytes(32)
+	if err != nil {
+		return err
+	}
+	obj["secret"] = base64.StdEncoding.EncodeToString(b)
+	return nil
+}
+
+func newRoot() (string, error) {
+	ca, err := minica.New()
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Root.Raw})), nil
+}
@@ -0,0 +1,255 @@
+// Package fakeapi is an in-memory implementation of the Smallstep API for
+// tests. It serves the endpoints of the embedded OpenAPI spec of every API
+// version the provider uses, validates requests against the spec and keeps the
+// objects it creates in memory.
+package fakeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apispec"
+)
+
+// Token is the bearer token the fake server accepts.
+const Token = "fakeapi-token"
+
+// Server is an in-memory Smallstep API served over HTTP.
+type Server struct {
+	// URL is the base URL of the API, used as the server of the API clients.
+	URL string
+
+	srv    *httptest.Server
+	router *apispec.Router
+
+	mu       sync.Mutex
+	objects  map[string][]map[string]any
+	single   map[string]map[string]any
+	faults   []*fault
+	pageSize int
+}
+
+// defaultPageSize is the number of objects the API returns in a page of a list
+// operation that takes pagination parameters, unless the request asks for
+// another size with pagination[first].
+const defaultPageSize = 100
+
+// route is an operation of the spec, with its path split into segments.
+type route struct {
+	*routers.Route
+	segments []string
+}
+
+type fault struct {
+	operationID string
+	status      int
+	remaining   int
+}
+
+var (
+	defaultServer *Server
+	defaultOnce   sync.Once
+)
+
+// Default returns a server shared by every test in the process. It is started
+// on first use and never closed.
+func Default() *Server {
+	defaultOnce.Do(func() {
+		defaultServer = New()
+	})
+	return defaultServer
+}
+
+// New starts a new server with no objects. Close it when done.
+func New() *Server {
+	s := &Server{
+		objects:  map[string][]map[string]any{},
+		single:   map[string]map[string]any{},
+		pageSize: defaultPageSize,
+	}
+	router, err := apispec.NewRouter()
+	if err != nil {
+		panic(fmt.Sprintf("fakeapi: %v", err))
+	}
+	s.router = router
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Fail makes the next n requests to the operation fail with the given status,
+// e.g. Fail("PostAuthorities", http.StatusServiceUnavailable, 1). A negative n
+// fails every request until Reset is called, and an n of 0 does nothing.
+func (s *Server) Fail(operationID string, status, n int) {
+	if n == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{operationID: operationID, status: status, remaining: n})
+}
+
+// SetPageSize sets the number of objects in a page of the list operations that
+// take pagination parameters, for requests that don't set pagination[first].
+// It defaults to 100, like the API.
+func (s *Server) SetPageSize(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pageSize = n
+}
+
+// Reset removes all objects and faults and restores the default page size.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects = map[string][]map[string]any{}
+	s.single = map[string]map[string]any{}
+	s.faults = nil
+	s.pageSize = defaultPageSize
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, http.StatusUnauthorized, "invalid bearer token")
+		return
+	}
+
+	found, params, err := s.router.Find(r.Header.Get(apispec.VersionHeader), r.Method, r.URL.EscapedPath())
+	switch {
+	case errors.Is(err, routers.ErrPathNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	case errors.Is(err, routers.ErrMethodNotAllowed):
+		writeError(w, http.StatusMethodNotAllowed, err.Error())
+		return
+	case err != nil:
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	segments, _ := apispec.SplitPath(r.URL.EscapedPath())
+	rt := &route{Route: found, segments: strings.Split(strings.Trim(found.Path, "/"), "/")}
+
+	if err := apispec.ValidateRequest(r.Context(), rt.Route, params, r); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The API treats null properties as missing, except in PATCH requests,
+	// where they unset properties.
+	var req map[string]any
+	if body, _ := io.ReadAll(r.Body); len(body) > 0 {
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if r.Method != http.MethodPatch {
+			apispec.RemoveNulls(req)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status := s.fault(rt.Operation.OperationID); status != 0 {
+		writeError(w, status, "injected fault")
+		return
+	}
+
+	status, resp, err := s.handle(rt, w.Header(), r, segments, req)
+	if err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+	if resp == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// isPaginated reports whether the route is a list operation that takes
+// pagination parameters.
+func (rt *route) isPaginated() bool {
+	for _, p := range rt.Operation.Parameters {
+		if p.Value != nil && p.Value.In == openapi3.ParameterInQuery && p.Value.Name == "pagination" {
+			return true
+		}
+	}
+	return false
+}
+
+// isItem reports whether the route addresses a single object in a collection,
+// e.g. /devices/{deviceID}.
+func (rt *route) isItem() bool {
+	return apispec.IsParam(rt.segments[len(rt.segments)-1])
+}
+
+// successStatus returns the lowest 2xx status the operation documents.
+func (rt *route) successStatus() int {
+	status := 0
+	for code := range rt.Operation.Responses.Map() {
+		n, err := strconv.Atoi(code)
+		if err != nil || n < 200 || n > 299 {
+			continue
+		}
+		if status == 0 || n < status {
+			status = n
+		}
+	}
+	if status == 0 {
+		return http.StatusOK
+	}
+	return status
+}
+
+// responseSchema returns the JSON schema of the successful response.
+func (rt *route) responseSchema() *openapi3.Schema {
+	resp := rt.Operation.Responses.Status(rt.successStatus())
+	if resp == nil || resp.Value == nil {
+		return nil
+	}
+	mt := resp.Value.Content.Get("application/json")
+	if mt == nil || mt.Schema == nil {
+		return nil
+	}
+	return mt.Schema.Value
+}
+
+func (s *Server) fault(operationID string) int {
+	for i, f := range s.faults {
+		if f.operationID != operationID {
+			continue
+		}
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return f.status
+	}
+	return 0
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"message": msg})
+}
+
+var errNotFound = errors.New("not found")
@@ -0,0 +1,389 @@
+package fakeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apispec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newClient returns a client that also checks the server's responses match
+// the spec.
+func newClient(t *testing.T, s *Server) *v20250101.Client {
+	transport, err := apispec.NewTransport(s.URL, http.DefaultTransport)
+	require.NoError(t, err)
+	client, err := v20250101.NewClient(s.URL, v20250101.WithHTTPClient(&http.Client{Transport: transport}), v20250101.WithRequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
+		r.Header.Set("Authorization", "Bearer "+Token)
+		return nil
+	}))
+	require.NoError(t, err)
+	return client
+}
+
+func decode[T any](t *testing.T, resp *http.Response, status int) *T {
+	t.Helper()
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, status, resp.StatusCode, string(body))
+	v := new(T)
+	require.NoError(t, json.Unmarshal(body, v))
+	return v
+}
+
+func TestServer_authority(t *testing.T) {
+	s := New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	resp, err := client.PostAuthorities(ctx, &v20250101.PostAuthoritiesParams{}, v20250101.PostAuthoritiesJSONRequestBody{
+		Name:        "Test Authority",
+		AdminEmails: []string{"eng@example.com"},
+		Subdomain:   "test",
+		Type:        "devops",
+	})
+	require.NoError(t, err)
+	authority := decode[v20250101.Authority](t, resp, http.StatusCreated)
+	assert.NotEmpty(t, authority.Id)
+	assert.Equal(t, "test.step-e2e.ca.smallstep.com", authority.Domain)
+	assert.Len(t, *authority.Fingerprint, 64)
+	assert.True(t, strings.HasPrefix(*authority.Root, "-----BEGIN CERTIFICATE-----"))
+	assert.False(t, authority.CreatedAt.IsZero())
+
+	resp, err = client.GetAuthority(ctx, authority.Domain, &v20250101.GetAuthorityParams{})
+	require.NoError(t, err)
+	got := decode[map[string]any](t, resp, http.StatusOK)
+	assert.Equal(t, authority.Id, (*got)["id"])
+	assert.NotContains(t, *got, "subdomain", "request-only properties are not returned")
+
+	resp, err = client.PostAuthorities(ctx, &v20250101.PostAuthoritiesParams{}, v20250101.PostAuthoritiesJSONRequestBody{
+		Name:        "Duplicate",
+		AdminEmails: []string{"eng@example.com"},
+		Subdomain:   "test",
+		Type:        "devops",
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = client.DeleteAuthority(ctx, authority.Id, &v20250101.DeleteAuthorityParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = client.GetAuthority(ctx, authority.Id, &v20250101.GetAuthorityParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServer_nested(t *testing.T) {
+	s := New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	resp, err := client.PostAuthorities(ctx, &v20250101.PostAuthoritiesParams{}, v20250101.PostAuthoritiesJSONRequestBody{
+		Name:        "Test Authority",
+		AdminEmails: []string{"eng@example.com"},
+		Subdomain:   "nested",
+		Type:        "devops",
+	})
+	require.NoError(t, err)
+	authority := decode[v20250101.Authority](t, resp, http.StatusCreated)
+
+	p := v20250101.Provisioner{Name: "acme provisioner", Type: "ACME"}
+	require.NoError(t, p.FromAcmeProvisioner(v20250101.AcmeProvisioner{
+		Challenges: []v20250101.AcmeProvisionerChallenges{v20250101.Http01},
+	}))
+	resp, err = client.PostAuthorityProvisioners(ctx, authority.Id, &v20250101.PostAuthorityProvisionersParams{}, p)
+	require.NoError(t, err)
+	provisioner := decode[v20250101.Provisioner](t, resp, http.StatusCreated)
+	require.NotNil(t, provisioner.Id)
+
+	url := "https://example.com/hook"
+	resp, err = client.PostWebhooks(ctx, authority.Id, "acme provisioner", &v20250101.PostWebhooksParams{}, v20250101.ProvisionerWebhook{
+		Name:       "hook",
+		Url:        &url,
+		Kind:       "ENRICHING",
+		CertType:   "ALL",
+		ServerType: "EXTERNAL",
+	})
+	require.NoError(t, err)
+	webhook := decode[v20250101.ProvisionerWebhook](t, resp, http.StatusCreated)
+	assert.NotEmpty(t, *webhook.Secret)
+
+	resp, err = client.GetWebhook(ctx, authority.Id, *provisioner.Id, "hook", &v20250101.GetWebhookParams{})
+	require.NoError(t, err)
+	got := decode[v20250101.ProvisionerWebhook](t, resp, http.StatusOK)
+	assert.Equal(t, webhook.Id, got.Id)
+
+	resp, err = client.ListAuthorityProvisioners(ctx, authority.Id, &v20250101.ListAuthorityProvisionersParams{})
+	require.NoError(t, err)
+	list := decode[[]v20250101.Provisioner](t, resp, http.StatusOK)
+	assert.Len(t, *list, 1)
+
+	// Deleting the authority deletes its provisioners and their webhooks.
+	resp, err = client.DeleteAuthority(ctx, authority.Id, &v20250101.DeleteAuthorityParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Empty(t, s.objects["authorities"])
+	assert.Len(t, s.objects, 1)
+}
+
+func TestServer_update(t *testing.T) {
+	s := New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	resp, err := client.PostManagedRadius(ctx, &v20250101.PostManagedRadiusParams{}, v20250101.ManagedRadius{
+		Name:     "radius",
+		NasIPs:   []string{"10.0.0.1"},
+		ClientCA: "-----BEGIN CERTIFICATE-----",
+	})
+	require.NoError(t, err)
+	radius := decode[v20250101.ManagedRadius](t, resp, http.StatusCreated)
+	assert.Nil(t, radius.Secret)
+	require.NotNil(t, radius.ServerCA)
+
+	resp, err = client.PutManagedRadius(ctx, *radius.Id, &v20250101.PutManagedRadiusParams{}, v20250101.ManagedRadius{
+		Name:     "renamed",
+		NasIPs:   []string{"10.0.0.2"},
+		ClientCA: "-----BEGIN CERTIFICATE-----",
+	})
+	require.NoError(t, err)
+	updated := decode[v20250101.ManagedRadius](t, resp, http.StatusOK)
+	assert.Equal(t, radius.Id, updated.Id)
+	assert.Equal(t, "renamed", updated.Name)
+	assert.Equal(t, radius.ServerCA, updated.ServerCA)
+
+	resp, err = client.GetManagedRadius(ctx, *radius.Id, &v20250101.GetManagedRadiusParams{Secret: &[]bool{true}[0]})
+	require.NoError(t, err)
+	withSecret := decode[v20250101.ManagedRadius](t, resp, http.StatusOK)
+	assert.NotEmpty(t, withSecret.Secret)
+}
+
+func TestServer_singleton(t *testing.T) {
+	s := New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	resp, err := client.GetIdentityProvider(ctx, &v20250101.GetIdentityProviderParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = client.PutIdentityProvider(ctx, &v20250101.PutIdentityProviderParams{}, v20250101.IdentityProvider{TrustRoots: "roots"})
+	require.NoError(t, err)
+	idp := decode[v20250101.IdentityProvider](t, resp, http.StatusOK)
+	assert.Equal(t, "roots", idp.TrustRoots)
+
+	resp, err = client.DeleteIdentityProvider(ctx, &v20250101.DeleteIdentityProviderParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestServer_alias(t *testing.T) {
+	s := New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	transport, err := apispec.NewTransport(s.URL, http.DefaultTransport)
+	require.NoError(t, err)
+	client2, err := v20260501.NewClient(s.URL, v20260501.WithHTTPClient(&http.Client{Transport: transport}), v20260501.WithRequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", "2026-05-01")
+		r.Header.Set("Authorization", "Bearer "+Token)
+		return nil
+	}))
+	require.NoError(t, err)
+
+	resp, err := client.PostIdpClients(ctx, &v20250101.PostIdpClientsParams{}, v20250101.IdpClient{RedirectURI: "https://example.com/callback"})
+	require.NoError(t, err)
+	idpClient := decode[v20250101.IdpClient](t, resp, http.StatusCreated)
+
+	// Clients created with the 2025-01-01 API are SSO integrations in the
+	// 2026-05-01 API.
+	resp, err = client2.GetSsoIntegration(ctx, *idpClient.Id, &v20260501.GetSsoIntegrationParams{})
+	require.NoError(t, err)
+	integration := decode[v20260501.SsoIntegration](t, resp, http.StatusOK)
+	assert.Equal(t, "https://example.com/callback", integration.RedirectURI)
+
+	resp, err = client2.DeleteSsoIntegration(ctx, *idpClient.Id, &v20260501.DeleteSsoIntegrationParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = client.GetIdpClient(ctx, *idpClient.Id, &v20250101.GetIdpClientParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServer_validation(t *testing.T) {
+	s := New()
+	defer s.Close()
+	// The client doesn't validate requests, so the server gets the invalid
+	// request.
+	client, err := v20250101.NewClient(s.URL, v20250101.WithRequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
+		r.Header.Set("Authorization", "Bearer "+Token)
+		return nil
+	}))
+	require.NoError(t, err)
+
+	resp, err := client.PostDevicesWithBody(context.Background(), &v20250101.PostDevicesParams{}, "application/json", strings.NewReader(`{"displayName": "laptop"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "permanentIdentifier")
+}
+
+func TestServer_headers(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		version string
+		token   string
+		want    int
+	}{
+		{"ok", "2026-05-01", Token, http.StatusOK},
+		{"missing version", "", Token, http.StatusBadRequest},
+		{"unknown version", "2020-01-01", Token, http.StatusBadRequest},
+		{"bad token", "2026-05-01", "other", http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := v20260501.NewClient(s.URL, v20260501.WithRequestEditorFn(func(ctx context.Context, r *http.Request) error {
+				if tc.version != "" {
+					r.Header.Set("X-Smallstep-Api-Version", tc.version)
+				}
+				r.Header.Set("Authorization", "Bearer "+tc.token)
+				return nil
+			}))
+			require.NoError(t, err)
+			resp, err := client.ListWorkloads(context.Background(), &v20260501.ListWorkloadsParams{})
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, tc.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestServer_Fail(t *testing.T) {
+	s := New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	s.Fail("ListDevices", http.StatusBadGateway, 0)
+	s.Fail("ListDevices", http.StatusServiceUnavailable, 1)
+
+	resp, err := client.ListDevices(ctx, &v20250101.ListDevicesParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	resp, err = client.ListDevices(ctx, &v20250101.ListDevicesParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	s.Fail("ListDevices", http.StatusInternalServerError, -1)
+	for range 3 {
+		resp, err = client.ListDevices(ctx, &v20250101.ListDevicesParams{})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	}
+
+	s.Reset()
+	resp, err = client.ListDevices(ctx, &v20250101.ListDevicesParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestServer_pagination(t *testing.T) {
+	s := New()
+	defer s.Close()
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	var want []string
+	for i := range 5 {
+		resp, err := client.PostDevices(ctx, &v20250101.PostDevicesParams{}, v20250101.DeviceRequest{
+			PermanentIdentifier: fmt.Sprintf("device-%d", i),
+		})
+		require.NoError(t, err)
+		want = append(want, decode[v20250101.Device](t, resp, http.StatusCreated).Id)
+	}
+
+	list := func(pagination *v20250101.Pagination) ([]string, string) {
+		t.Helper()
+		resp, err := client.ListDevices(ctx, &v20250101.ListDevicesParams{Pagination: pagination})
+		require.NoError(t, err)
+		var ids []string
+		for _, device := range *decode[[]v20250101.Device](t, resp, http.StatusOK) {
+			ids = append(ids, device.Id)
+		}
+		return ids, resp.Header.Get("X-Next-Cursor")
+	}
+
+	// Every device fits in the default page.
+	got, cursor := list(nil)
+	assert.Equal(t, want, got)
+	assert.Empty(t, cursor)
+
+	// The generated clients send unset pagination fields as "<nil>", so the
+	// first page is requested without pagination and the next pages with
+	// both fields.
+	s.SetPageSize(2)
+	size := 2
+	got = nil
+	var pages int
+	var pagination *v20250101.Pagination
+	for {
+		ids, cursor := list(pagination)
+		got = append(got, ids...)
+		pages++
+		if cursor == "" {
+			break
+		}
+		pagination = &v20250101.Pagination{First: &size, After: &cursor}
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, 3, pages)
+
+	// pagination[first] overrides the page size.
+	first, bogus := 3, "bogus"
+	got, cursor = list(nil)
+	assert.Equal(t, want[:2], got)
+	got, cursor = list(&v20250101.Pagination{First: &first, After: &cursor})
+	assert.Equal(t, want[2:], got)
+	assert.Empty(t, cursor)
+
+	resp, err := client.ListDevices(ctx, &v20250101.ListDevicesParams{Pagination: &v20250101.Pagination{First: &first, After: &bogus}})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
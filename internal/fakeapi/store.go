@@ -0,0 +1,423 @@
+package fakeapi
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apispec"
+)
+
+// collectionAliases maps the leading segments of paths of objects that are not
+// under the path of their collection to the collection, e.g.
+// /credential/{credentialID} to /credentials. Both sides have the same number
+// of segments.
+var collectionAliases = map[string]string{
+	"credential": "credentials",
+	// This assumes the 2026-05-01 API serves the identity provider clients of
+	// earlier versions as SSO integrations with the same IDs, which moved
+	// blocks from smallstep_identity_provider_client rely on. The API has not
+	// confirmed it.
+	"integrations/sso": "sso/clients",
+}
+
+// page returns the page of objects a list request asks for with its
+// pagination[first] and pagination[after] query parameters, and the cursor of
+// the next page, or "" on the last page. Cursors are opaque to clients; here
+// they encode the index of the first object of the page.
+func (s *Server) page(r *http.Request, objs []map[string]any) ([]map[string]any, string, error) {
+	q := r.URL.Query()
+	size := s.pageSize
+	if first := q.Get("pagination[first]"); first != "" {
+		n, err := strconv.Atoi(first)
+		if err != nil || n < 1 {
+			return nil, "", fmt.Errorf("invalid pagination[first] %q", first)
+		}
+		size = n
+	}
+	start := 0
+	if after := q.Get("pagination[after]"); after != "" {
+		b, err := base64.RawURLEncoding.DecodeString(after)
+		if err == nil {
+			start, err = strconv.Atoi(string(b))
+		}
+		if err != nil || start < 0 || start > len(objs) {
+			return nil, "", fmt.Errorf("invalid pagination[after] %q", after)
+		}
+	}
+	end := min(start+size, len(objs))
+	if end == len(objs) {
+		return objs[start:], "", nil
+	}
+	return objs[start:end], base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(end))), nil
+}
+
+// handle runs the operation against the in-memory store. Objects are kept in
+// collections keyed by their path, with the IDs of parent objects in place of
+// the names or domains used in the request, e.g.
+// authorities/<id>/provisioners/<id>/webhooks. Paths that are not collections,
+// like /sso, hold a single object. Pages of paginated lists set the
+// X-Next-Cursor header when there is a next page.
+func (s *Server) handle(rt *route, header http.Header, r *http.Request, segments []string, req map[string]any) (int, any, error) {
+	segments = unalias(segments)
+	keys, err := s.resolveParents(rt, segments)
+	if err != nil {
+		return http.StatusNotFound, nil, err
+	}
+	status := rt.successStatus()
+	schema := rt.responseSchema()
+
+	respond := func(obj map[string]any) (int, any, error) {
+		if status == http.StatusNoContent || schema == nil {
+			return status, nil, nil
+		}
+		if hook, ok := readHooks[rt.Operation.OperationID]; ok {
+			obj = hook(r, clone(obj))
+		}
+		return status, prune(schema, obj), nil
+	}
+
+	if !rt.isItem() {
+		key := strings.Join(keys, "/")
+		switch r.Method {
+		case http.MethodPost:
+			obj := req
+			if obj == nil {
+				obj = map[string]any{}
+			}
+			if err := s.create(rt, r, key, obj, schema); err != nil {
+				return http.StatusBadRequest, nil, err
+			}
+			return respond(obj)
+		case http.MethodGet:
+			if schema != nil && schema.Type.Is(openapi3.TypeArray) {
+				objs := s.objects[key]
+				if rt.isPaginated() {
+					var next string
+					objs, next, err = s.page(r, objs)
+					if err != nil {
+						return http.StatusBadRequest, nil, err
+					}
+					if next != "" {
+						header.Set("X-Next-Cursor", next)
+					}
+				}
+				list := []any{}
+				for _, obj := range objs {
+					if hook, ok := readHooks[rt.Operation.OperationID]; ok {
+						obj = hook(r, clone(obj))
+					}
+					list = append(list, prune(schema.Items.Value, obj))
+				}
+				return status, list, nil
+			}
+			obj, ok := s.single[key]
+			if !ok {
+				return http.StatusNotFound, nil, errNotFound
+			}
+			return respond(obj)
+		case http.MethodPut:
+			if req == nil {
+				req = map[string]any{}
+			}
+			if err := runWriteHook(rt, req); err != nil {
+				return http.StatusBadRequest, nil, err
+			}
+			s.single[key] = req
+			return respond(req)
+		case http.MethodPatch:
+			obj := s.single[key]
+			if obj == nil {
+				obj = map[string]any{}
+			}
+			obj = mergePatch(obj, req)
+			if err := runWriteHook(rt, obj); err != nil {
+				return http.StatusBadRequest, nil, err
+			}
+			s.single[key] = obj
+			return respond(obj)
+		case http.MethodDelete:
+			// Like the API, deleting something that doesn't exist
+			// succeeds.
+			delete(s.single, key)
+			return status, nil, nil
+		}
+		return http.StatusMethodNotAllowed, nil, fmt.Errorf("method %s not allowed", r.Method)
+	}
+
+	collection := strings.Join(keys[:len(keys)-1], "/")
+	i := s.find(collection, keys[len(keys)-1])
+	if i < 0 {
+		return http.StatusNotFound, nil, errNotFound
+	}
+	old := s.objects[collection][i]
+
+	switch r.Method {
+	case http.MethodGet:
+		return respond(old)
+	case http.MethodPut:
+		obj := req
+		if obj == nil {
+			obj = map[string]any{}
+		}
+		// Properties the client can't set, like the ID and creation time,
+		// are kept.
+		settable, open := properties(requestSchema(rt))
+		for k, v := range old {
+			if p, ok := settable[k]; (!ok && !open) || (p != nil && p.ReadOnly) {
+				obj[k] = v
+			}
+		}
+		obj["id"] = old["id"]
+		if err := runWriteHook(rt, obj); err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+		s.objects[collection][i] = obj
+		return respond(obj)
+	case http.MethodPatch:
+		obj := mergePatch(old, req)
+		obj["id"] = old["id"]
+		if err := runWriteHook(rt, obj); err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+		s.objects[collection][i] = obj
+		return respond(obj)
+	case http.MethodDelete:
+		s.objects[collection] = append(s.objects[collection][:i], s.objects[collection][i+1:]...)
+		s.deleteChildren(collection + "/" + fmt.Sprint(old["id"]))
+		return status, nil, nil
+	}
+	return http.StatusMethodNotAllowed, nil, fmt.Errorf("method %s not allowed", r.Method)
+}
+
+func unalias(segments []string) []string {
+	for from, to := range collectionAliases {
+		prefix := strings.Split(from, "/")
+		if len(segments) >= len(prefix) && slices.Equal(segments[:len(prefix)], prefix) {
+			return append(strings.Split(to, "/"), segments[len(prefix):]...)
+		}
+	}
+	return segments
+}
+
+// create assigns the ID and other properties the API sets and adds the object
+// to the collection.
+func (s *Server) create(rt *route, r *http.Request, collection string, obj map[string]any, schema *openapi3.Schema) error {
+	props, _ := properties(schema)
+	if _, ok := props["id"]; ok {
+		obj["id"] = uuid.NewString()
+	}
+	if _, ok := props["createdAt"]; ok {
+		obj["createdAt"] = time.Now().UTC().Format(time.RFC3339)
+	}
+	if hook, ok := createHooks[rt.Operation.OperationID]; ok {
+		if err := hook(s, r, obj); err != nil {
+			return err
+		}
+	}
+	if err := runWriteHook(rt, obj); err != nil {
+		return err
+	}
+	for _, name := range required(schema) {
+		if _, ok := obj[name]; !ok {
+			obj[name] = zeroValue(props[name])
+		}
+	}
+	s.objects[collection] = append(s.objects[collection], obj)
+	return nil
+}
+
+// resolveParents replaces the names and domains of parent objects in the
+// request path with their IDs.
+func (s *Server) resolveParents(rt *route, segments []string) ([]string, error) {
+	keys := append([]string(nil), segments...)
+	for i, seg := range rt.segments[:len(rt.segments)-1] {
+		if !apispec.IsParam(seg) {
+			continue
+		}
+		collection := strings.Join(keys[:i], "/")
+		j := s.find(collection, keys[i])
+		if j < 0 {
+			return nil, fmt.Errorf("%s %q not found", strings.TrimSuffix(keys[i-1], "s"), keys[i])
+		}
+		keys[i] = fmt.Sprint(s.objects[collection][j]["id"])
+	}
+	return keys, nil
+}
+
+// find returns the index of the object in the collection with the given ID,
+// or -1. Objects can also be referenced by the name, domain or slug the API
+// accepts in place of an ID.
+func (s *Server) find(collection, ref string) int {
+	for _, attr := range []string{"id", "name", "domain", "slug"} {
+		for i, obj := range s.objects[collection] {
+			if v, ok := obj[attr].(string); ok && v == ref {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (s *Server) deleteChildren(prefix string) {
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix+"/") {
+			delete(s.objects, key)
+		}
+	}
+	for key := range s.single {
+		if strings.HasPrefix(key, prefix+"/") {
+			delete(s.single, key)
+		}
+	}
+}
+
+func requestSchema(rt *route) *openapi3.Schema {
+	if rt.Operation.RequestBody == nil || rt.Operation.RequestBody.Value == nil {
+		return nil
+	}
+	mt := rt.Operation.RequestBody.Value.Content.Get("application/json")
+	if mt == nil || mt.Schema == nil {
+		return nil
+	}
+	return mt.Schema.Value
+}
+
+// properties returns the properties of an object schema, including those of
+// its allOf, oneOf and anyOf schemas. A property defined differently by two
+// schemas maps to nil. The second result is true when the schema allows
+// properties that are not listed.
+func properties(schema *openapi3.Schema) (map[string]*openapi3.Schema, bool) {
+	props := map[string]*openapi3.Schema{}
+	if schema == nil {
+		return props, true
+	}
+	open := false
+	var walk func(*openapi3.Schema)
+	walk = func(sc *openapi3.Schema) {
+		for name, ref := range sc.Properties {
+			if prev, ok := props[name]; ok && prev != ref.Value {
+				props[name] = nil
+				continue
+			}
+			props[name] = ref.Value
+		}
+		subschemas := append(append(append(openapi3.SchemaRefs{}, sc.AllOf...), sc.OneOf...), sc.AnyOf...)
+		for _, ref := range subschemas {
+			walk(ref.Value)
+		}
+		if len(sc.Properties) == 0 && len(subschemas) == 0 {
+			open = true
+		}
+		if ap := sc.AdditionalProperties; ap.Has != nil && *ap.Has || ap.Schema != nil {
+			open = true
+		}
+	}
+	walk(schema)
+	return props, open
+}
+
+func required(schema *openapi3.Schema) []string {
+	if schema == nil {
+		return nil
+	}
+	names := append([]string(nil), schema.Required...)
+	for _, ref := range schema.AllOf {
+		names = append(names, required(ref.Value)...)
+	}
+	return names
+}
+
+// prune removes the properties the schema doesn't define, like request-only
+// properties, the same way the API only returns what its schemas document.
+func prune(schema *openapi3.Schema, v any) any {
+	if schema == nil {
+		return v
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		props, open := properties(schema)
+		out := make(map[string]any, len(v))
+		for name, val := range v {
+			p, ok := props[name]
+			switch {
+			case ok:
+				out[name] = prune(p, val)
+			case open:
+				out[name] = val
+			}
+		}
+		return out
+	case []any:
+		if schema.Items == nil {
+			return v
+		}
+		out := make([]any, len(v))
+		for i := range v {
+			out[i] = prune(schema.Items.Value, v[i])
+		}
+		return out
+	}
+	return v
+}
+
+func zeroValue(schema *openapi3.Schema) any {
+	if schema == nil || schema.Type == nil {
+		return nil
+	}
+	switch {
+	case schema.Type.Is(openapi3.TypeString):
+		switch schema.Format {
+		case "uuid":
+			return uuid.NewString()
+		case "date-time":
+			return time.Now().UTC().Format(time.RFC3339)
+		}
+		if len(schema.Enum) > 0 {
+			return schema.Enum[0]
+		}
+		return ""
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return false
+	case schema.Type.Is(openapi3.TypeInteger), schema.Type.Is(openapi3.TypeNumber):
+		return 0
+	case schema.Type.Is(openapi3.TypeArray):
+		return []any{}
+	case schema.Type.Is(openapi3.TypeObject):
+		return map[string]any{}
+	}
+	return nil
+}
+
+// mergePatch applies a JSON merge patch (RFC 7396) to a copy of obj.
+func mergePatch(obj, patch map[string]any) map[string]any {
+	out := clone(obj)
+	for k, v := range patch {
+		if v == nil {
+			delete(out, k)
+			continue
+		}
+		if pm, ok := v.(map[string]any); ok {
+			if om, ok := out[k].(map[string]any); ok {
+				out[k] = mergePatch(om, pm)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+func clone(obj map[string]any) map[string]any {
+	out := make(map[string]any, len(obj))
+	for k, v := range obj {
+		out[k] = v
+	}
+	return out
+}
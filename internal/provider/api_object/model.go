@@ -0,0 +1,162 @@
+package api_object
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const typeName = "smallstep_api_object"
+
+const (
+	apiVersion20250101 = "2025-01-01"
+	apiVersion20260501 = "2026-05-01"
+)
+
+type Model struct {
+	ID          types.String `tfsdk:"id"`
+	Path        types.String `tfsdk:"path"`
+	APIVersion  types.String `tfsdk:"api_version"`
+	Body        types.String `tfsdk:"body"`
+	IDAttribute types.String `tfsdk:"id_attribute"`
+	ObjectPath  types.String `tfsdk:"object_path"`
+	Response    types.String `tfsdk:"response"`
+}
+
+// objectID returns the ID of the object in a create response. Numeric IDs are
+// returned as they are in the response, so large integers keep every digit.
+func objectID(response []byte, idAttribute string) (string, error) {
+	v, err := decodeJSON(response)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	obj, _ := v.(map[string]any)
+	switch id := obj[idAttribute].(type) {
+	case string:
+		if id != "" {
+			return id, nil
+		}
+	case json.Number:
+		return id.String(), nil
+	}
+	return "", fmt.Errorf("response does not have a %q property", idAttribute)
+}
+
+// objectPath returns the path of an object in the collection at collectionPath.
+func objectPath(collectionPath, id string) string {
+	return strings.TrimSuffix(collectionPath, "/") + "/" + url.PathEscape(id)
+}
+
+// remoteBody returns the body to store in state after reading the object.
+// Properties the API sets that are not in the configured body, like IDs and
+// timestamps, are ignored, so only changes to the configured properties are
+// reported as drift. The configured body is kept when the API returns the same
+// values, even if formatted differently. Without a configured body, e.g. after
+// an import, the full response is used.
+func remoteBody(body types.String, response []byte) (types.String, error) {
+	remote, err := decodeJSON(response)
+	if err != nil {
+		return types.String{}, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !body.IsNull() && !body.IsUnknown() {
+		if desired, err := decodeJSON([]byte(body.ValueString())); err == nil {
+			remote = project(desired, remote)
+			if jsonEqual(desired, remote) {
+				return body, nil
+			}
+		}
+	}
+
+	b, err := json.Marshal(remote)
+	if err != nil {
+		return types.String{}, err
+	}
+	return types.StringValue(string(b)), nil
+}
+
+// decodeJSON decodes JSON with its numbers as json.Number, so integers above
+// 2^53, like some IDs, serials and nanosecond timestamps, keep every digit.
+func decodeJSON(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// jsonEqual reports whether two values returned by decodeJSON are equal.
+// Numbers are compared exactly by value, so 1 and 1.0 are equal.
+func jsonEqual(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if bv, ok := b[k]; !ok || !jsonEqual(v, bv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, okA := new(big.Rat).SetString(a.String())
+		y, okB := new(big.Rat).SetString(b.String())
+		return okA && okB && x.Cmp(y) == 0
+	default:
+		return a == b
+	}
+}
+
+// project returns the parts of remote that are present in desired.
+func project(desired, remote any) any {
+	switch d := desired.(type) {
+	case map[string]any:
+		r, ok := remote.(map[string]any)
+		if !ok {
+			return remote
+		}
+		out := make(map[string]any, len(d))
+		for k, v := range d {
+			if rv, ok := r[k]; ok {
+				out[k] = project(v, rv)
+			}
+		}
+		return out
+	case []any:
+		r, ok := remote.([]any)
+		if !ok || len(r) != len(d) {
+			return remote
+		}
+		out := make([]any, len(r))
+		for i := range r {
+			out[i] = project(d[i], r[i])
+		}
+		return out
+	default:
+		return remote
+	}
+}
@@ -0,0 +1,96 @@
+package api_object
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteBody(t *testing.T) {
+	response := `{"id":"abc","createdAt":"2025-01-01T00:00:00Z","name":"wifi","ssid":"office","hidden":false,"credentials":["c1","c2"],"nested":{"a":1,"b":2},"serial":9007199254740993}`
+
+	tests := []struct {
+		name string
+		body types.String
+		want types.String
+	}{
+		{
+			name: "unchanged",
+			body: types.StringValue(`{"name":"wifi","hidden":false,"nested":{"a":1}}`),
+			want: types.StringValue(`{"name":"wifi","hidden":false,"nested":{"a":1}}`),
+		},
+		{
+			name: "unchanged with different formatting",
+			body: types.StringValue("{\n  \"ssid\": \"office\",\n  \"name\": \"wifi\"\n}"),
+			want: types.StringValue("{\n  \"ssid\": \"office\",\n  \"name\": \"wifi\"\n}"),
+		},
+		{
+			name: "drift",
+			body: types.StringValue(`{"name":"wifi","hidden":true}`),
+			want: types.StringValue(`{"hidden":false,"name":"wifi"}`),
+		},
+		{
+			name: "unchanged number with different formatting",
+			body: types.StringValue(`{"nested":{"a":1.0}}`),
+			want: types.StringValue(`{"nested":{"a":1.0}}`),
+		},
+		{
+			name: "unchanged large integer",
+			body: types.StringValue(`{"serial":9007199254740993}`),
+			want: types.StringValue(`{"serial":9007199254740993}`),
+		},
+		{
+			name: "drift in large integer",
+			body: types.StringValue(`{"serial":9007199254740992}`),
+			want: types.StringValue(`{"serial":9007199254740993}`),
+		},
+		{
+			name: "drift in nested object",
+			body: types.StringValue(`{"nested":{"a":3}}`),
+			want: types.StringValue(`{"nested":{"a":1}}`),
+		},
+		{
+			name: "drift in list",
+			body: types.StringValue(`{"credentials":["c1"]}`),
+			want: types.StringValue(`{"credentials":["c1","c2"]}`),
+		},
+		{
+			name: "removed property",
+			body: types.StringValue(`{"name":"wifi","autojoin":true}`),
+			want: types.StringValue(`{"name":"wifi"}`),
+		},
+		{
+			name: "imported",
+			body: types.StringNull(),
+			want: types.StringValue(`{"createdAt":"2025-01-01T00:00:00Z","credentials":["c1","c2"],"hidden":false,"id":"abc","name":"wifi","nested":{"a":1,"b":2},"serial":9007199254740993,"ssid":"office"}`),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := remoteBody(tc.body, []byte(response))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestObjectID(t *testing.T) {
+	id, err := objectID([]byte(`{"id":"abc","name":"wifi"}`), "id")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", id)
+
+	id, err = objectID([]byte(`{"slug":"team","name":"wifi"}`), "slug")
+	require.NoError(t, err)
+	assert.Equal(t, "team", id)
+
+	id, err = objectID([]byte(`{"id":9007199254740993,"name":"wifi"}`), "id")
+	require.NoError(t, err)
+	assert.Equal(t, "9007199254740993", id)
+
+	_, err = objectID([]byte(`{"name":"wifi"}`), "id")
+	assert.ErrorContains(t, err, `response does not have a "id" property`)
+
+	assert.Equal(t, "/protect/wifi/a%2Fb", objectPath("/protect/wifi/", "a/b"))
+}
@@ -0,0 +1,426 @@
+package api_object
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+)
+
+var _ resource.ResourceWithImportState = (*Resource)(nil)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource manages any object of the Smallstep API with raw JSON, for
+// endpoints the provider does not model yet.
+type Resource struct {
+	clients *clientset.Clients
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = typeName
+}
+
+// Configure adds the Smallstep API clients to the resource.
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*clientset.Clients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Get Smallstep API client from provider",
+			fmt.Sprintf("Expected *clientset.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an object of the Smallstep API that does not have a dedicated resource yet with raw JSON. " +
+			"The object is created with a POST of `body` to `path` and then read, updated and deleted with GET, PUT and DELETE requests to `object_path`. " +
+			"Only the properties set in `body` are checked for drift. Prefer a dedicated resource once the provider supports the object.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the object, read from the `id_attribute` property of the create response.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the collection the object is created in, relative to the API URL, e.g. `/authorities/{authorityID}/provisioners`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with /"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The API version used for every request, `%s` or `%s`.", apiVersion20250101, apiVersion20260501),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(apiVersion20250101, apiVersion20260501),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "The JSON object sent to create and update the object. Use `jsonencode` to build it.",
+				Required:            true,
+			},
+			"id_attribute": schema.StringAttribute{
+				MarkdownDescription: "The property of the create response that holds the object's ID. Defaults to `id`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the object, `path` followed by its ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "The JSON object returned by the API when the object was last created, updated or read. Use `jsondecode` to get properties set by the API.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "create")
+
+	var plan Model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateBody(plan.Body.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("body"), "Invalid body", err.Error())
+		return
+	}
+
+	httpResp, err := r.do(ctx, plan.APIVersion.ValueString(), http.MethodPost, plan.Path.ValueString(), []byte(plan.Body.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to create object in %s: %v", plan.Path.ValueString(), err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusCreated && httpResp.StatusCode != http.StatusOK {
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d: %s", reqID, httpResp.StatusCode, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to read object created in %s: %v", plan.Path.ValueString(), err),
+		)
+		return
+	}
+
+	id, err := objectID(body, plan.IDAttribute.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to get ID of object created in %s: %v", plan.Path.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.ObjectPath = types.StringValue(objectPath(plan.Path.ValueString(), id))
+	plan.Response = types.StringValue(string(body))
+
+	tflog.Trace(ctx, fmt.Sprintf("create api object %q resource", plan.ObjectPath.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "read")
+
+	state := &Model{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objPath := state.ObjectPath.ValueString()
+	httpResp, err := r.do(ctx, state.APIVersion.ValueString(), http.MethodGet, objPath, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to read object %s: %v", objPath, err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d reading object %s: %s", reqID, httpResp.StatusCode, objPath, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to read object %s: %v", objPath, err),
+		)
+		return
+	}
+
+	state.Body, err = remoteBody(state.Body, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to unmarshal object %s: %v", objPath, err),
+		)
+		return
+	}
+	state.Response = types.StringValue(string(body))
+
+	tflog.Trace(ctx, fmt.Sprintf("read api object %q resource", objPath))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "update")
+
+	var plan, state Model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateBody(plan.Body.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("body"), "Invalid body", err.Error())
+		return
+	}
+
+	objPath := state.ObjectPath.ValueString()
+	httpResp, err := r.do(ctx, plan.APIVersion.ValueString(), http.MethodPut, objPath, []byte(plan.Body.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to update object %s: %v", objPath, err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d updating object %s: %s", reqID, httpResp.StatusCode, objPath, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to read object %s: %v", objPath, err),
+		)
+		return
+	}
+	// Some endpoints do not return the updated object.
+	if len(bytes.TrimSpace(body)) == 0 {
+		body = []byte(plan.Body.ValueString())
+	}
+
+	plan.ID = state.ID
+	plan.ObjectPath = state.ObjectPath
+	plan.Response = types.StringValue(string(body))
+
+	tflog.Trace(ctx, fmt.Sprintf("update api object %q resource", objPath))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "delete")
+
+	var state Model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objPath := state.ObjectPath.ValueString()
+	httpResp, err := r.do(ctx, state.APIVersion.ValueString(), http.MethodDelete, objPath, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to delete object %s: %v", objPath, err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	switch httpResp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+	default:
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d deleting object %s: %s", reqID, httpResp.StatusCode, objPath, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	apiVersion, objPath, ok := strings.Cut(req.ID, ":")
+	i := strings.LastIndex(objPath, "/")
+	if !ok || i <= 0 || i == len(objPath)-1 || !strings.HasPrefix(objPath, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			`Import ID must be "<api_version>:<object_path>", e.g. "2025-01-01:/authorities/{authorityID}/provisioners/{provisionerID}"`,
+		)
+		return
+	}
+	id, err := url.PathUnescape(objPath[i+1:])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_version"), apiVersion)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), objPath[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_path"), objPath)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id_attribute"), "id")...)
+}
+
+// do sends a request to the Smallstep API with the client for apiVersion, so
+// that it is authenticated, retried, rate limited and logged like the requests
+// of every other resource.
+func (r *Resource) do(ctx context.Context, apiVersion, method, p string, body []byte) (*http.Response, error) {
+	var (
+		server string
+		doer   interface {
+			Do(*http.Request) (*http.Response, error)
+		}
+		editors []func(context.Context, *http.Request) error
+	)
+	switch apiVersion {
+	case apiVersion20250101:
+		server, doer = r.clients.V20250101.Server, r.clients.V20250101.Client
+		for _, fn := range r.clients.V20250101.RequestEditors {
+			editors = append(editors, fn)
+		}
+	case apiVersion20260501:
+		server, doer = r.clients.V20260501.Server, r.clients.V20260501.Client
+		for _, fn := range r.clients.V20260501.RequestEditors {
+			editors = append(editors, fn)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported api version %q", apiVersion)
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+	u, err := serverURL.Parse("." + p)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, fn := range editors {
+		if err := fn(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return doer.Do(req)
+}
+
+func validateBody(body string) error {
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(body), &obj); err != nil {
+		return fmt.Errorf("body must be a JSON object: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package api_object
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/testprovider"
+)
+
+var provider = &testprovider.SmallstepTestProvider{
+	ResourceFactories: []func() resource.Resource{
+		NewResource,
+	},
+}
+
+var providerFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"smallstep": providerserver.NewProtocol6WithError(provider),
+}
+
+func TestAccAPIObjectResource(t *testing.T) {
+	root, _ := utils.CACerts(t)
+	name := "tfprovider-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ssid := "ssid-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+
+	config := func(hidden bool) string {
+		return fmt.Sprintf(`
+resource "smallstep_api_object" "wifi" {
+	path = "/protect/wifi"
+	api_version = "2025-01-01"
+	body = jsonencode({
+		name = %q
+		ssid = %q
+		radiusServerCA = %q
+		credentials = []
+		hidden = %t
+	})
+}`, name, ssid, root, hidden)
+	}
+
+	helper.Test(t, helper.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []helper.TestStep{
+			{
+				Config: config(false),
+				Check: helper.ComposeAggregateTestCheckFunc(
+					helper.TestMatchResourceAttr("smallstep_api_object.wifi", "id", regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)),
+					helper.TestMatchResourceAttr("smallstep_api_object.wifi", "object_path", regexp.MustCompile(`^/protect/wifi/[0-9a-f-]{36}$`)),
+					helper.TestCheckResourceAttr("smallstep_api_object.wifi", "id_attribute", "id"),
+					helper.TestMatchResourceAttr("smallstep_api_object.wifi", "response", regexp.MustCompile(name)),
+				),
+			},
+			{
+				Config: config(true),
+				ConfigPlanChecks: helper.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("smallstep_api_object.wifi", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: helper.ComposeAggregateTestCheckFunc(
+					helper.TestMatchResourceAttr("smallstep_api_object.wifi", "response", regexp.MustCompile(`"hidden":\s*true`)),
+				),
+			},
+			{
+				ResourceName:            "smallstep_api_object.wifi",
+				ImportState:             true,
+				ImportStateIdPrefix:     "2025-01-01:/protect/wifi/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "response"},
+			},
+		},
+	})
+}
@@ -12,8 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -25,7 +25,7 @@ func NewDataSource() datasource.DataSource {
 
 // DataSource implements data.smallstep_authority
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (a *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,10 +49,12 @@ func (a *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	a.client = clients.V20250101
+	a.client = clients.Pinned
 }
 
 func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+authorityTypeName, "read")
+
 	var data DataModel
 
 	// Read Terraform configuration data into the model
@@ -66,7 +68,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		id = data.Domain.ValueString()
 	}
 
-	httpResp, err := a.client.GetAuthority(ctx, id, &v20250101.GetAuthorityParams{})
+	httpResp, err := a.client.GetAuthority(ctx, id, &v20260501.GetAuthorityParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -85,7 +87,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	authority := &v20250101.Authority{}
+	authority := &v20260501.Authority{}
 	if err := json.NewDecoder(httpResp.Body).Decode(authority); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -115,13 +117,54 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	}
 	data.AdminEmails = adminEmailsSet
 
+	provisionersResp, err := a.client.ListAuthorityProvisioners(ctx, authority.Id, &v20260501.ListAuthorityProvisionersParams{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to list provisioners of authority %s: %v", authority.Id, err),
+		)
+		return
+	}
+	defer provisionersResp.Body.Close()
+
+	if provisionersResp.StatusCode != http.StatusOK {
+		reqID := provisionersResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d listing provisioners of authority %s: %s", reqID, provisionersResp.StatusCode, authority.Id, utils.APIErrorMsg(provisionersResp.Body)),
+		)
+		return
+	}
+
+	var provisioners []v20260501.Provisioner
+	if err := json.NewDecoder(provisionersResp.Body).Decode(&provisioners); err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to unmarshal provisioners of authority %s: %v", authority.Id, err),
+		)
+		return
+	}
+
+	acmeDirectories := map[string]attr.Value{}
+	for _, p := range provisioners {
+		if p.Type == v20260501.ACME || p.Type == v20260501.ACMEATTESTATION {
+			acmeDirectories[p.Name] = types.StringValue(acmeDirectory(authority.Domain, p.Name))
+		}
+	}
+	acmeDirectoriesMap, diags := types.MapValue(types.StringType, acmeDirectories)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ACMEDirectories = acmeDirectoriesMap
+
 	tflog.Trace(ctx, fmt.Sprintf("read authority %q data source", data.ID.ValueString()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	component, properties, err := utils.Describe("authority")
+	component, properties, err := utils.DescribeV20260501("authority")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI spec",
@@ -173,6 +216,11 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"acme_directories": schema.MapAttribute{
+				MarkdownDescription: "The ACME directory URL of each ACME and ACME attestation provisioner of the authority, keyed by provisioner name. Use these to configure ACME clients such as cert-manager.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -2,6 +2,7 @@ package authority
 
 import (
 	"fmt"
+	"net/url"
 	"testing"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 func TestAccAuthorityDataSource(t *testing.T) {
 	t.Parallel()
 	authority := utils.NewAuthority(t)
+	acme := utils.NewACMEProvisioner(t, authority.Id)
+	acmeDirectory := fmt.Sprintf("https://%s/acme/%s/directory", authority.Domain, url.PathEscape(acme.Name))
 	byID := fmt.Sprintf(`
 data "smallstep_authority" "test" {
 	id = "%s"
@@ -33,6 +36,8 @@ data "smallstep_authority" "test" {
 					resource.TestCheckResourceAttr("data.smallstep_authority.test", "fingerprint", *authority.Fingerprint),
 					resource.TestCheckResourceAttr("data.smallstep_authority.test", "root", *authority.Root),
 					resource.TestCheckResourceAttr("data.smallstep_authority.test", "admin_emails.0", (*authority.AdminEmails)[0]),
+					resource.TestCheckResourceAttr("data.smallstep_authority.test", "acme_directories.%", "1"),
+					resource.TestCheckResourceAttr("data.smallstep_authority.test", "acme_directories."+acme.Name, acmeDirectory),
 				),
 			},
 		},
@@ -59,6 +64,8 @@ data "smallstep_authority" "test" {
 					resource.TestCheckResourceAttr("data.smallstep_authority.test", "fingerprint", *authority.Fingerprint),
 					resource.TestCheckResourceAttr("data.smallstep_authority.test", "root", *authority.Root),
 					resource.TestCheckResourceAttr("data.smallstep_authority.test", "admin_emails.0", (*authority.AdminEmails)[0]),
+					resource.TestCheckResourceAttr("data.smallstep_authority.test", "acme_directories.%", "1"),
+					resource.TestCheckResourceAttr("data.smallstep_authority.test", "acme_directories."+acme.Name, acmeDirectory),
 				),
 			},
 		},
@@ -2,10 +2,11 @@ package authority
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 )
 
 // type name for both resources and data sources
@@ -21,6 +22,12 @@ type DataModel struct {
 	CreatedAt        types.String `tfsdk:"created_at"`
 	ActiveRevocation types.Bool   `tfsdk:"active_revocation"`
 	AdminEmails      types.Set    `tfsdk:"admin_emails"`
+	ACMEDirectories  types.Map    `tfsdk:"acme_directories"`
+}
+
+// acmeDirectory returns the URL of the ACME directory of a provisioner.
+func acmeDirectory(domain, provisionerName string) string {
+	return "https://" + domain + "/acme/" + url.PathEscape(provisionerName) + "/directory"
 }
 
 type ResourceModel struct {
@@ -36,6 +43,7 @@ type ResourceModel struct {
 	AdminEmails        types.Set        `tfsdk:"admin_emails"`
 	IntermediateIssuer *X509IssuerModel `tfsdk:"intermediate_issuer"`
 	RootIssuer         *X509IssuerModel `tfsdk:"root_issuer"`
+	DeletionProtection types.Bool       `tfsdk:"deletion_protection"`
 }
 
 type X509IssuerModel struct {
@@ -47,7 +55,7 @@ type X509IssuerModel struct {
 	Subject         *DistinguishedNameModel `tfsdk:"subject"`
 }
 
-func (issuer *X509IssuerModel) AsAPI(ctx context.Context) (*v20250101.X509Issuer, diag.Diagnostics) {
+func (issuer *X509IssuerModel) AsAPI(ctx context.Context) (*v20260501.X509Issuer, diag.Diagnostics) {
 	if issuer == nil {
 		return nil, diag.Diagnostics{}
 	}
@@ -59,9 +67,9 @@ func (issuer *X509IssuerModel) AsAPI(ctx context.Context) (*v20250101.X509Issuer
 
 	maxPathLength := int(issuer.MaxPathLength.ValueInt64())
 
-	return &v20250101.X509Issuer{
+	return &v20260501.X509Issuer{
 		Duration:        issuer.Duration.ValueStringPointer(),
-		KeyVersion:      v20250101.X509IssuerKeyVersion(issuer.KeyVersion.ValueString()),
+		KeyVersion:      v20260501.X509IssuerKeyVersion(issuer.KeyVersion.ValueString()),
 		MaxPathLength:   &maxPathLength,
 		Name:            issuer.Name.ValueString(),
 		NameConstraints: nameConstraints,
@@ -81,7 +89,7 @@ type NameConstraintsModel struct {
 	PermittedURIDomains     types.Set  `tfsdk:"permitted_uri_domains"`
 }
 
-func (nc *NameConstraintsModel) AsAPI(ctx context.Context) (*v20250101.NameConstraints, diag.Diagnostics) {
+func (nc *NameConstraintsModel) AsAPI(ctx context.Context) (*v20260501.NameConstraints, diag.Diagnostics) {
 	var d diag.Diagnostics
 
 	if nc == nil {
@@ -109,7 +117,7 @@ func (nc *NameConstraintsModel) AsAPI(ctx context.Context) (*v20250101.NameConst
 		return nil, d
 	}
 
-	return &v20250101.NameConstraints{
+	return &v20260501.NameConstraints{
 		Critical:                nc.Critical.ValueBoolPointer(),
 		ExcludedDNSDomains:      excludedDNSDomains,
 		ExcludedEmailAddresses:  excludedEmailAddresses,
@@ -135,12 +143,12 @@ type DistinguishedNameModel struct {
 	StreetAddress      types.String `tfsdk:"street_address"`
 }
 
-func (dn *DistinguishedNameModel) AsAPI() *v20250101.DistinguishedName {
+func (dn *DistinguishedNameModel) AsAPI() *v20260501.DistinguishedName {
 	if dn == nil {
 		return nil
 	}
 
-	return &v20250101.DistinguishedName{
+	return &v20260501.DistinguishedName{
 		CommonName:         dn.CommonName.ValueStringPointer(),
 		Country:            dn.Country.ValueStringPointer(),
 		EmailAddress:       dn.EmailAddress.ValueStringPointer(),
@@ -12,19 +12,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithModifyPlan = (*Resource)(nil)
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -32,7 +36,7 @@ func NewResource() resource.Resource {
 
 // Resource defines the resource implementation.
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,15 +44,15 @@ func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, r
 }
 
 func (r *Resource) x509IssuerSchema() (map[string]schema.Attribute, error) {
-	_, properties, err := utils.Describe("x509Issuer")
+	_, properties, err := utils.DescribeV20260501("x509Issuer")
 	if err != nil {
 		return nil, err
 	}
-	_, nameConstraints, err := utils.Describe("nameConstraints")
+	_, nameConstraints, err := utils.DescribeV20260501("nameConstraints")
 	if err != nil {
 		return nil, err
 	}
-	_, subject, err := utils.Describe("distinguishedName")
+	_, subject, err := utils.DescribeV20260501("distinguishedName")
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +69,9 @@ func (r *Resource) x509IssuerSchema() (map[string]schema.Attribute, error) {
 		"duration": schema.StringAttribute{
 			Optional:            true,
 			MarkdownDescription: properties["duration"],
+			Validators: []validator.String{
+				validators.Duration(),
+			},
 		},
 		"max_path_length": schema.Int64Attribute{
 			Optional:            true,
@@ -170,7 +177,7 @@ func (r *Resource) x509IssuerSchema() (map[string]schema.Attribute, error) {
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	component, properties, err := utils.Describe("authority")
+	component, properties, err := utils.DescribeV20260501("authority")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI authority schema",
@@ -238,6 +245,12 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				MarkdownDescription: properties["createdAt"],
 				Computed:            true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent terraform from deleting or replacing the authority. Set to `false` and apply before destroying or replacing the authority. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"active_revocation": schema.BoolAttribute{
 				MarkdownDescription: properties["activeRevocation"],
 				Optional:            true,
@@ -291,10 +304,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, authorityTypeName, "create")
+
 	var data ResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -316,18 +331,16 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	reqBody := v20250101.PostAuthoritiesJSONRequestBody{
+	reqBody := v20260501.PostAuthoritiesJSONRequestBody{
 		Name:               data.Name.ValueString(),
 		ActiveRevocation:   data.ActiveRevocation.ValueBoolPointer(),
 		AdminEmails:        adminEmails,
 		IntermediateIssuer: intermediate,
 		RootIssuer:         root,
 		Subdomain:          data.Subdomain.ValueString(),
-		Type:               v20250101.NewAuthorityType(data.Type.ValueString()),
+		Type:               v20260501.NewAuthorityType(data.Type.ValueString()),
 	}
-	b, _ := json.Marshal(reqBody)
-	tflog.Debug(ctx, string(b))
-	httpResp, err := a.client.PostAuthorities(ctx, &v20250101.PostAuthoritiesParams{}, reqBody)
+	httpResp, err := a.client.PostAuthorities(ctx, &v20260501.PostAuthoritiesParams{}, reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -346,7 +359,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	authority := &v20250101.Authority{}
+	authority := &v20260501.Authority{}
 	if err := json.NewDecoder(httpResp.Body).Decode(authority); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -367,6 +380,8 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, authorityTypeName, "read")
+
 	var data ResourceModel
 
 	// Read Terraform configuration data into the model
@@ -381,7 +396,7 @@ func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		id = data.Domain.ValueString()
 	}
 
-	httpResp, err := a.client.GetAuthority(ctx, id, &v20250101.GetAuthorityParams{})
+	httpResp, err := a.client.GetAuthority(ctx, id, &v20260501.GetAuthorityParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -405,7 +420,7 @@ func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	authority := &v20250101.Authority{}
+	authority := &v20260501.Authority{}
 	if err := json.NewDecoder(httpResp.Body).Decode(authority); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -442,19 +457,54 @@ func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		data.Subdomain = types.StringValue(parts[0])
 	}
 
+	// Deletion protection is only tracked in state. Imported authorities get
+	// the default.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(true)
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("read authority %q resource", id))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"All changes require replacement",
+// ModifyPlan warns when a change replaces the authority. Replacing an authority
+// deletes its keys and root and intermediate certificates, so every
+// certificate it issued stops being trusted.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || len(resp.RequiresReplace) == 0 {
+		// Create, delete or in-place change
+		return
+	}
+
+	attrs := make([]string, len(resp.RequiresReplace))
+	for i, p := range resp.RequiresReplace {
+		attrs[i] = p.String()
+	}
+	resp.Diagnostics.AddWarning(
+		"Smallstep authority will be replaced",
+		fmt.Sprintf("Changing %s replaces the authority. The current authority, its keys and its root and intermediate certificates will be deleted and every certificate it issued will stop being trusted.", strings.Join(attrs, ", ")),
 	)
 }
 
+// Update only changes deletion_protection since every other attribute
+// requires replacement. Neither the 2025-01-01 nor the 2026-05-01 API has an
+// endpoint to update an authority, so admin_emails, active_revocation and name
+// cannot be changed in place until one is added.
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var deletionProtection types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), deletionProtection)...)
+}
+
 func (a *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, authorityTypeName, "delete")
+
 	var data ResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -463,7 +513,15 @@ func (a *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := a.client.DeleteAuthority(ctx, data.ID.ValueString(), &v20250101.DeleteAuthorityParams{})
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Smallstep authority is protected from deletion",
+			fmt.Sprintf("Authority %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying or replacing it.", data.Domain.ValueString()),
+		)
+		return
+	}
+
+	httpResp, err := a.client.DeleteAuthority(ctx, data.ID.ValueString(), &v20260501.DeleteAuthorityParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
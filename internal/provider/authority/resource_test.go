@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/smallstep/terraform-provider-smallstep/internal/testprovider"
 )
 
@@ -39,6 +40,15 @@ resource "smallstep_authority" "devops" {
 	type = "devops"
 	admin_emails = ["andrew@smallstep.com"]
 }
+`, devopsSlug, devopsSlug)
+	unprotectedDevopsConfig := fmt.Sprintf(`
+resource "smallstep_authority" "devops" {
+	subdomain = "%s"
+	name = "%s Authority"
+	type = "devops"
+	admin_emails = ["andrew@smallstep.com"]
+	deletion_protection = false
+}
 `, devopsSlug, devopsSlug)
 
 	caDomain := os.Getenv("SMALLSTEP_CA_DOMAIN")
@@ -57,6 +67,7 @@ resource "smallstep_authority" "devops" {
 					helper.TestMatchResourceAttr("smallstep_authority.devops", "fingerprint", regexp.MustCompile(`^[0-9a-z]{64}$`)),
 					helper.TestMatchResourceAttr("smallstep_authority.devops", "root", regexp.MustCompile(`-----BEGIN`)),
 					helper.TestMatchResourceAttr("smallstep_authority.devops", "created_at", regexp.MustCompile(`^20\d\d-\d\d-\d\dT\d\d:\d\d:\d\dZ`)),
+					helper.TestCheckResourceAttr("smallstep_authority.devops", "deletion_protection", "true"),
 				),
 			},
 			{
@@ -70,6 +81,20 @@ resource "smallstep_authority" "devops" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config:      devopsConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("protected from deletion"),
+			},
+			{
+				Config: unprotectedDevopsConfig,
+				ConfigPlanChecks: helper.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("smallstep_authority.devops", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: helper.TestCheckResourceAttr("smallstep_authority.devops", "deletion_protection", "false"),
+			},
 		},
 	})
 
@@ -81,6 +106,7 @@ resource "smallstep_authority" "advanced" {
 	type = "advanced"
 	admin_emails = ["andrew@smallstep.com"]
 	active_revocation = true
+	deletion_protection = false
 	intermediate_issuer = {
 		name = "%s Intermediate"
 		key_version = "RSA_SIGN_PKCS1_2048_SHA256"
@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -23,7 +23,7 @@ func NewDataSource() datasource.DataSource {
 }
 
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (ds *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,11 +47,11 @@ func (ds *DataSource) Configure(ctx context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	ds.client = clients.V20250101
+	ds.client = clients.Pinned
 }
 
 func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	browser, props, err := utils.Describe("browser")
+	browser, props, err := utils.DescribeV20260501("browser")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Browser Schema",
@@ -87,6 +87,8 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 }
 
 func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+name, "read")
+
 	var id string
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
@@ -94,7 +96,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	httpResp, err := ds.client.GetBrowser(ctx, id, &v20250101.GetBrowserParams{})
+	httpResp, err := ds.client.GetBrowser(ctx, id, &v20260501.GetBrowserParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -117,7 +119,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	browser := &v20250101.Browser{}
+	browser := &v20260501.Browser{}
 	if err := json.NewDecoder(httpResp.Body).Decode(browser); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
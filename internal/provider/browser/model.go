@@ -7,7 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -20,8 +20,8 @@ type BrowserModel struct {
 	Credentials    types.Set    `tfsdk:"credentials"`
 }
 
-func (model *BrowserModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20250101.Browser {
-	browser := &v20250101.Browser{}
+func (model *BrowserModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20260501.Browser {
+	browser := &v20260501.Browser{}
 
 	if !model.Name.IsNull() && !model.Name.IsUnknown() {
 		browser.Name = model.Name.ValueStringPointer()
@@ -42,7 +42,7 @@ func (model *BrowserModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *
 	return browser
 }
 
-func FromAPI(ctx context.Context, browser *v20250101.Browser, diags *diag.Diagnostics, state utils.AttributeGetter) *BrowserModel {
+func FromAPI(ctx context.Context, browser *v20260501.Browser, diags *diag.Diagnostics, state utils.AttributeGetter) *BrowserModel {
 	model := &BrowserModel{
 		ID: types.StringPointerValue(browser.Id),
 	}
@@ -12,8 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -24,11 +24,11 @@ func NewResource() resource.Resource {
 }
 
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	browser, props, err := utils.Describe("browser")
+	browser, props, err := utils.DescribeV20260501("browser")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Browser Schema",
@@ -91,10 +91,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, name, "read")
+
 	state := &BrowserModel{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -111,7 +113,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	httpResp, err := r.client.GetBrowser(ctx, browserID, &v20250101.GetBrowserParams{})
+	httpResp, err := r.client.GetBrowser(ctx, browserID, &v20260501.GetBrowserParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -134,7 +136,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	browser := &v20250101.Browser{}
+	browser := &v20260501.Browser{}
 	if err := json.NewDecoder(httpResp.Body).Decode(browser); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -152,6 +154,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "create")
+
 	plan := &BrowserModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -163,7 +167,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PostBrowser(ctx, &v20250101.PostBrowserParams{}, *reqBody)
+	httpResp, err := r.client.PostBrowser(ctx, &v20260501.PostBrowserParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -182,7 +186,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	browser := &v20250101.Browser{}
+	browser := &v20260501.Browser{}
 	if err := json.NewDecoder(httpResp.Body).Decode(browser); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -200,6 +204,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "update")
+
 	plan := &BrowserModel{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
@@ -213,7 +219,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PutBrowser(ctx, browserID, &v20250101.PutBrowserParams{}, *reqBody)
+	httpResp, err := r.client.PutBrowser(ctx, browserID, &v20260501.PutBrowserParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -237,7 +243,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	browser := &v20250101.Browser{}
+	browser := &v20260501.Browser{}
 	if err := json.NewDecoder(httpResp.Body).Decode(browser); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -253,6 +259,8 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, name, "delete")
+
 	state := &BrowserModel{}
 	diags := req.State.Get(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -269,7 +277,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.DeleteBrowser(ctx, browserID, &v20250101.DeleteBrowserParams{})
+	httpResp, err := r.client.DeleteBrowser(ctx, browserID, &v20260501.DeleteBrowserParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -4,24 +4,33 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
-	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
+const (
+	// defaultTokenLifetime is used when the token's expiry can't be parsed.
+	defaultTokenLifetime = time.Hour
+	// tokenRefreshMargin is how long before expiry a token is refreshed. It is
+	// capped at a quarter of the token's lifetime for short-lived tokens.
+	tokenRefreshMargin = 5 * time.Minute
+)
+
 type createTokenReq struct {
-	TeamID string   `json:"teamID"`
-	Bundle [][]byte `json:"bundle"`
+	TeamID   string   `json:"teamID,omitempty"`
+	TeamSlug string   `json:"teamSlug,omitempty"`
+	Bundle   [][]byte `json:"bundle"`
 }
 
 type createTokenResp struct {
@@ -30,118 +39,199 @@ type createTokenResp struct {
 }
 
 // Uses a client cert to get an API token and returns clients using that token.
-// Renews the token just before its 1 hour expiry in case of long running
-// terraform applies.
-func apiClientWithClientCert(ctx context.Context, server, teamID, cert, key string) (*clientset.Clients, error) {
-	if _, err := uuid.Parse(teamID); err != nil {
-		return nil, fmt.Errorf("team-id argument must be a valid UUID")
+// The team is identified by teamID if set, otherwise by teamSlug. The pinned
+// client requests apiVersion.
+// The token is refreshed shortly before it expires or when the API rejects it
+// in case of long running terraform applies.
+func apiClientWithClientCert(ctx context.Context, server, apiVersion, teamID, teamSlug string, clientCert tls.Certificate, cfg transportConfig) (*clientset.Clients, error) {
+	source, err := newClientCertTokenSource(server, teamID, teamSlug, clientCert, cfg.newBaseTransport(), cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	clientCert, err := tls.X509KeyPair([]byte(cert), []byte(key))
-	if err != nil {
+	// Get the first token now so that an invalid certificate is reported when
+	// the provider is configured.
+	if _, err := source.Token(ctx); err != nil {
 		return nil, err
 	}
 
+	return newClientset(server, apiVersion, &http.Client{
+		Transport: &tokenTransport{
+			source: source,
+			base:   cfg.wrap(cfg.newBaseTransport()),
+		},
+	})
+}
+
+// clientCertTokenSource gets API tokens from the auth endpoint with a client
+// certificate. It is safe for concurrent use and shared by all API clients.
+type clientCertTokenSource struct {
+	authURL string
+	body    []byte
+	client  *http.Client
+	now     func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+	// fetching is set while a new token is being requested so that concurrent
+	// callers wait for it instead of requesting their own.
+	fetching *tokenFetch
+}
+
+// tokenFetch is a request for a new token. done is closed once token and err
+// are set.
+type tokenFetch struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+func newClientCertTokenSource(server, teamID, teamSlug string, clientCert tls.Certificate, transport *http.Transport, cfg transportConfig) (*clientCertTokenSource, error) {
+	if teamID != "" {
+		if _, err := uuid.Parse(teamID); err != nil {
+			return nil, fmt.Errorf("team-id argument must be a valid UUID")
+		}
+		teamSlug = ""
+	} else if teamSlug == "" {
+		return nil, fmt.Errorf("team-id or team-slug argument is required")
+	}
+
 	authURL, err := url.JoinPath(server, "auth")
 	if err != nil {
 		return nil, err
 	}
 
-	r := &createTokenReq{
-		TeamID: teamID,
-		Bundle: clientCert.Certificate,
-	}
-
-	b, err := json.Marshal(r)
+	b, err := json.Marshal(&createTokenReq{
+		TeamID:   teamID,
+		TeamSlug: teamSlug,
+		Bundle:   clientCert.Certificate,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var tkn string
-	var m sync.RWMutex
-	getTkn := func() error {
-		post, err := http.NewRequest("POST", authURL, bytes.NewBuffer(b))
-		if err != nil {
-			return err
-		}
-		post.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
-		post.Header.Set("Content-Type", "application/json")
-		transport := http.DefaultTransport.(*http.Transport).Clone()
-		transport.TLSClientConfig = &tls.Config{
-			GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
-				return &clientCert, nil
-			},
-			MinVersion: tls.VersionTLS12,
-		}
-		client := http.Client{
-			Transport: transport,
-		}
-		resp, err := client.Do(post)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return &clientCert, nil
+	}
+	transport.TLSClientConfig.MinVersion = tls.VersionTLS12
 
-		if resp.StatusCode != 201 {
-			msg := utils.APIErrorMsg(resp.Body)
-			return fmt.Errorf("Failed to create Smallstep API token with provided client certificate - the certificate may be expired or invalid. Response: %d. Details: %s", resp.StatusCode, msg)
-		}
+	return &clientCertTokenSource{
+		authURL: authURL,
+		body:    b,
+		client:  &http.Client{Transport: cfg.wrap(transport)},
+		now:     time.Now,
+	}, nil
+}
 
-		respBody := &createTokenResp{}
-		if err := json.NewDecoder(resp.Body).Decode(respBody); err != nil {
-			return err
+// Token returns the current token, getting a new one if it is missing or
+// about to expire. The lock is not held while the token is requested; callers
+// that need a token in the meantime wait for the same request.
+func (s *clientCertTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	if s.token != "" && s.now().Before(s.refreshAt) {
+		tkn := s.token
+		s.mu.Unlock()
+		return tkn, nil
+	}
+	if f := s.fetching; f != nil {
+		s.mu.Unlock()
+		select {
+		case <-f.done:
+			return f.token, f.err
+		case <-ctx.Done():
+			return "", ctx.Err()
 		}
+	}
+	f := &tokenFetch{done: make(chan struct{})}
+	s.fetching = f
+	s.mu.Unlock()
+
+	issued := s.now()
+	f.token, f.err = s.fetch(ctx)
+
+	s.mu.Lock()
+	s.fetching = nil
+	if f.err == nil {
+		s.token = f.token
+		s.refreshAt = tokenRefreshAt(f.token, issued)
+	}
+	s.mu.Unlock()
+	close(f.done)
 
-		m.Lock()
-		tkn = respBody.Token
-		m.Unlock()
+	return f.token, f.err
+}
 
-		tflog.Info(ctx, "Created new Smallstep API token with client certificate")
+// Invalidate forces the next call to Token to get a new token if tkn is still
+// the current token. It returns false if tkn was already replaced.
+func (s *clientCertTokenSource) Invalidate(tkn string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-		return nil
+	if s.token != tkn {
+		return false
 	}
+	s.token = ""
+	return true
+}
 
-	if err := getTkn(); err != nil {
-		return nil, err
+func (s *clientCertTokenSource) fetch(ctx context.Context) (string, error) {
+	post, err := http.NewRequestWithContext(ctx, "POST", s.authURL, bytes.NewReader(s.body))
+	if err != nil {
+		return "", err
 	}
-	go func() {
-		ticker := time.NewTicker(time.Minute * 59)
+	post.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
+	post.Header.Set("Content-Type", "application/json")
 
-		select {
-		case <-ctx.Done():
-			ticker.Stop()
-			return
-		case <-ticker.C:
-			if err := getTkn(); err != nil {
-				tflog.Error(ctx, "Failed to get Smallstep API token with client certificate")
-			}
-		}
-	}()
-
-	apiClient20250101, err := v20250101.NewClient(server, v20250101.WithRequestEditorFn(v20250101.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
-		m.RLock()
-		r.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
-		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tkn))
-		m.RUnlock()
-		return nil
-	})))
+	resp, err := s.client.Do(post)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	defer resp.Body.Close()
 
-	apiClient20260501, err := v20260501.NewClient(server, v20260501.WithRequestEditorFn(v20260501.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
-		m.RLock()
-		r.Header.Set("X-Smallstep-Api-Version", "2026-05-01")
-		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tkn))
-		m.RUnlock()
-		return nil
-	})))
+	if resp.StatusCode != 201 {
+		msg := utils.APIErrorMsg(resp.Body)
+		return "", fmt.Errorf("Failed to create Smallstep API token with provided client certificate - the certificate may be expired or invalid. Response: %d. Details: %s", resp.StatusCode, msg)
+	}
+
+	respBody := &createTokenResp{}
+	if err := json.NewDecoder(resp.Body).Decode(respBody); err != nil {
+		return "", err
+	}
+
+	tflog.Info(ctx, "Created new Smallstep API token with client certificate")
+
+	return respBody.Token, nil
+}
+
+// tokenExpiry reads the exp claim from a JWT without verifying it. The API
+// verifies the token; the provider only needs to know when to refresh it.
+func tokenExpiry(tkn string, now time.Time) time.Time {
+	parts := strings.Split(tkn, ".")
+	if len(parts) != 3 {
+		return now.Add(defaultTokenLifetime)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
 	if err != nil {
-		return nil, err
+		return now.Add(defaultTokenLifetime)
 	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return now.Add(defaultTokenLifetime)
+	}
+	return time.Unix(claims.Exp, 0)
+}
 
-	return &clientset.Clients{
-		V20250101: apiClient20250101,
-		V20260501: apiClient20260501,
-	}, nil
+// tokenRefreshAt returns when a token issued at the given time should be
+// replaced: tokenRefreshMargin before it expires, or a quarter of its lifetime
+// before it expires if that is shorter.
+func tokenRefreshAt(tkn string, issued time.Time) time.Time {
+	expiry := tokenExpiry(tkn, issued)
+	margin := min(tokenRefreshMargin, expiry.Sub(issued)/4)
+	return expiry.Add(-max(margin, 0))
 }
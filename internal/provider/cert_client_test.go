@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.step.sm/crypto/keyutil"
+	"go.step.sm/crypto/minica"
+	"go.step.sm/crypto/x509util"
+)
+
+func testJWT(sub string, exp time.Time) string {
+	enc := base64.RawURLEncoding
+	header := enc.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := enc.EncodeToString([]byte(fmt.Sprintf(`{"sub":%q,"exp":%d}`, sub, exp.Unix())))
+	return header + "." + payload + ".sig"
+}
+
+// fakeAuthServer issues a new token on every call that expires lifetime after
+// the current time of the fake clock. If block is set, requests wait until it
+// is closed.
+type fakeAuthServer struct {
+	*httptest.Server
+	t        *testing.T
+	now      func() time.Time
+	lifetime time.Duration
+	block    chan struct{}
+	mu       sync.Mutex
+	calls    int
+}
+
+func newFakeAuthServer(t *testing.T, now func() time.Time) *fakeAuthServer {
+	s := &fakeAuthServer{t: t, now: now, lifetime: time.Hour}
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/auth", r.URL.Path)
+		assert.NotEmpty(t, r.TLS.PeerCertificates, "missing client certificate")
+
+		body := &createTokenReq{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		assert.Equal(t, "my-team", body.TeamSlug)
+		assert.Len(t, body.Bundle, 1)
+
+		s.mu.Lock()
+		s.calls++
+		tkn := testJWT(fmt.Sprintf("token-%d", s.calls), s.now().Add(s.lifetime))
+		block := s.block
+		s.mu.Unlock()
+
+		if block != nil {
+			<-block
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(createTokenResp{Token: tkn}))
+	}))
+	s.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	s.StartTLS()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeAuthServer) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func testClientCert(t *testing.T) tls.Certificate {
+	ca, err := minica.New()
+	require.NoError(t, err)
+	signer, err := keyutil.GenerateDefaultSigner()
+	require.NoError(t, err)
+	cr, err := x509util.CreateCertificateRequest("client", nil, signer)
+	require.NoError(t, err)
+	leaf, err := ca.SignCSR(cr)
+	require.NoError(t, err)
+	return tls.Certificate{
+		Certificate: [][]byte{leaf.Raw},
+		PrivateKey:  signer,
+		Leaf:        leaf,
+	}
+}
+
+func newTestTokenSource(t *testing.T, server *fakeAuthServer, now func() time.Time) *clientCertTokenSource {
+	transport := server.Client().Transport.(*http.Transport).Clone()
+	source, err := newClientCertTokenSource(server.URL, "", "my-team", testClientCert(t), transport, transportConfig{})
+	require.NoError(t, err)
+	source.now = now
+	return source
+}
+
+func TestClientCertTokenSource(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time { return clock }
+	server := newFakeAuthServer(t, now)
+	source := newTestTokenSource(t, server, now)
+
+	tkn1, err := source.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.Calls())
+
+	// The token is reused until it is about to expire.
+	clock = clock.Add(50 * time.Minute)
+	tkn, err := source.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, tkn1, tkn)
+	assert.Equal(t, 1, server.Calls())
+
+	clock = clock.Add(6 * time.Minute)
+	tkn2, err := source.Token(t.Context())
+	require.NoError(t, err)
+	assert.NotEqual(t, tkn1, tkn2)
+	assert.Equal(t, 2, server.Calls())
+
+	// Renewal keeps working for applies longer than a single token lifetime.
+	for i := 0; i < 5; i++ {
+		clock = clock.Add(time.Hour)
+		_, err := source.Token(t.Context())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 7, server.Calls())
+
+	// Invalidating an old token does not discard the current one.
+	assert.False(t, source.Invalidate(tkn1))
+	current, err := source.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 7, server.Calls())
+
+	assert.True(t, source.Invalidate(current))
+	_, err = source.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 8, server.Calls())
+}
+
+func TestClientCertTokenSource_shortLifetime(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time { return clock }
+	server := newFakeAuthServer(t, now)
+	server.lifetime = 8 * time.Minute
+	source := newTestTokenSource(t, server, now)
+
+	tkn1, err := source.Token(t.Context())
+	require.NoError(t, err)
+
+	// The margin is a quarter of the lifetime instead of five minutes.
+	clock = clock.Add(5 * time.Minute)
+	tkn, err := source.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, tkn1, tkn)
+	assert.Equal(t, 1, server.Calls())
+
+	clock = clock.Add(time.Minute)
+	tkn, err = source.Token(t.Context())
+	require.NoError(t, err)
+	assert.NotEqual(t, tkn1, tkn)
+	assert.Equal(t, 2, server.Calls())
+}
+
+func TestClientCertTokenSource_concurrent(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time { return clock }
+	server := newFakeAuthServer(t, now)
+	server.block = make(chan struct{})
+	source := newTestTokenSource(t, server, now)
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 5)
+	for i := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tkn, err := source.Token(t.Context())
+			assert.NoError(t, err)
+			tokens[i] = tkn
+		}()
+	}
+
+	// Callers waiting for the pending request give up when their context is
+	// done since the lock is not held during the request.
+	require.Eventually(t, func() bool { return server.Calls() == 1 }, 5*time.Second, time.Millisecond)
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	_, err := source.Token(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(server.block)
+	wg.Wait()
+	assert.Equal(t, 1, server.Calls())
+	for _, tkn := range tokens {
+		assert.Equal(t, tokens[0], tkn)
+	}
+}
+
+func TestClientCertTokenSource_rejected(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"certificate expired"}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	transport := server.Client().Transport.(*http.Transport).Clone()
+	source, err := newClientCertTokenSource(server.URL, "", "my-team", testClientCert(t), transport, transportConfig{})
+	require.NoError(t, err)
+
+	_, err = source.Token(t.Context())
+	require.ErrorContains(t, err, "certificate expired")
+}
+
+func TestTokenTransport(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time { return clock }
+	authServer := newFakeAuthServer(t, now)
+	source := newTestTokenSource(t, authServer, now)
+
+	// The API rejects the first token as if it had been revoked.
+	revoked, err := source.Token(t.Context())
+	require.NoError(t, err)
+
+	var bodies []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer "+revoked {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.NotEmpty(t, r.Header.Get("X-Smallstep-Api-Version"))
+		if r.Method == http.MethodPost {
+			b, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			bodies = append(bodies, string(b))
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer api.Close()
+
+	clients, err := newClientset(api.URL, clientset.Version20250101, &http.Client{
+		Transport: &tokenTransport{
+			source: source,
+			base:   http.DefaultTransport,
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := clients.V20250101.PostAuthorities(t.Context(), &v20250101.PostAuthoritiesParams{}, v20250101.PostAuthoritiesJSONRequestBody{
+		Name: "test",
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Len(t, bodies, 1)
+	assert.Contains(t, bodies[0], `"name":"test"`)
+	assert.Equal(t, 2, authServer.Calls())
+
+	// The refreshed token is shared with the other API version.
+	resp, err = clients.V20260501.GetAuthorities(t.Context(), &v20260501.GetAuthoritiesParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, authServer.Calls())
+}
+
+// replacedTokenSource behaves as if another request replaced the token after
+// it was handed out.
+type replacedTokenSource struct {
+	tokens []string
+}
+
+func (s *replacedTokenSource) Token(context.Context) (string, error) {
+	tkn := s.tokens[0]
+	if len(s.tokens) > 1 {
+		s.tokens = s.tokens[1:]
+	}
+	return tkn, nil
+}
+
+func (s *replacedTokenSource) Invalidate(string) bool {
+	return false
+}
+
+func TestTokenTransport_replaced(t *testing.T) {
+	var auth []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "Bearer old" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer api.Close()
+
+	clients, err := newClientset(api.URL, clientset.Version20250101, &http.Client{
+		Transport: &tokenTransport{
+			source: &replacedTokenSource{tokens: []string{"old", "new"}},
+			base:   http.DefaultTransport,
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := clients.V20250101.GetAuthorities(t.Context(), &v20250101.GetAuthoritiesParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer old", "Bearer new"}, auth)
+}
+
+func TestTokenTransport_static(t *testing.T) {
+	calls := 0
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer api.Close()
+
+	clients, err := newClientset(api.URL, clientset.Version20250101, &http.Client{
+		Transport: &tokenTransport{
+			source: staticTokenSource("abc"),
+			base:   http.DefaultTransport,
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := clients.V20250101.GetAuthorities(t.Context(), &v20250101.GetAuthoritiesParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.step.sm/crypto/keyutil"
+	"go.step.sm/crypto/kms"
+	"go.step.sm/crypto/kms/apiv1"
+	"go.step.sm/crypto/pemutil"
+	"software.sslmate.com/src/go-pkcs12"
+
+	// Enable the KMS implementations supported by private_key_uri. softkms is
+	// always registered by the kms package.
+	_ "go.step.sm/crypto/kms/awskms"
+	_ "go.step.sm/crypto/kms/pkcs11"
+)
+
+// loadClientCertificate builds the TLS certificate used to get an API token
+// from either a PKCS#12 bundle or a PEM certificate and private key. The PEM
+// values may be inline or read from files and the private key may be
+// encrypted or held in a KMS.
+func loadClientCertificate(ctx context.Context, cc *ClientCertificateModel) (tls.Certificate, error) {
+	if !cc.PKCS12File.IsNull() {
+		return loadPKCS12(cc.PKCS12File.ValueString(), cc.PKCS12Password.ValueString())
+	}
+
+	certPEM, certName, err := inlineOrFile(cc.Certificate, cc.CertificateFile, "certificate")
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	certs, err := pemutil.ParseCertificateBundle(certPEM)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to parse %s: %w", certName, err)
+	}
+
+	if !cc.PrivateKeyURI.IsNull() {
+		if !cc.PrivateKey.IsNull() || !cc.PrivateKeyFile.IsNull() {
+			return tls.Certificate{}, errors.New("the client certificate private key uri cannot be combined with a private key or private key file")
+		}
+		signer, err := kmsSigner(ctx, cc.PrivateKeyURI.ValueString(), cc.PrivateKeyPassword.ValueString())
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+		return newTLSCertificate(signer, certs)
+	}
+
+	keyPEM, keyName, err := inlineOrFile(cc.PrivateKey, cc.PrivateKeyFile, "private key")
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	opts := []pemutil.Options{pemutil.WithFilename(keyName)}
+	if pass := cc.PrivateKeyPassword.ValueString(); pass != "" {
+		opts = append(opts, pemutil.WithPassword([]byte(pass)))
+	}
+	key, err := pemutil.ParseKey(keyPEM, opts...)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return newTLSCertificate(key, certs)
+}
+
+// kmsSigner resolves a KMS URI such as pkcs11:, awskms: or softkms: to a
+// signer. The password is used as the PKCS #11 PIN or to decrypt a softkms
+// key. The key manager is left open since the signer is used for every token
+// request.
+func kmsSigner(ctx context.Context, uri, password string) (crypto.Signer, error) {
+	km, err := kms.New(ctx, apiv1.Options{
+		URI: uri,
+		Pin: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize KMS for %s: %w", uri, err)
+	}
+
+	req := &apiv1.CreateSignerRequest{
+		SigningKey: uri,
+	}
+	if password != "" {
+		req.Password = []byte(password)
+	}
+	signer, err := km.CreateSigner(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key %s: %w", uri, err)
+	}
+	return signer, nil
+}
+
+// privateKeyURIValidator rejects pkcs11: URIs when the provider is built
+// without cgo, as the released binaries are, instead of failing with a KMS
+// error when the provider is configured.
+type privateKeyURIValidator struct{}
+
+func (v privateKeyURIValidator) Description(_ context.Context) string {
+	return "pkcs11: URIs require a provider built with cgo"
+}
+
+func (v privateKeyURIValidator) MarkdownDescription(ctx context.Context) string {
+	return "`pkcs11:` URIs require a provider built with cgo"
+}
+
+func (v privateKeyURIValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if pkcs11Supported || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !strings.HasPrefix(strings.ToLower(req.ConfigValue.ValueString()), "pkcs11:") {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"PKCS #11 Not Supported",
+		"This build of the provider does not support pkcs11: private key URIs because it was built without cgo, as the released binaries are. "+
+			"Build the provider with CGO_ENABLED=1 to use a PKCS #11 token such as a YubiKey, or use an awskms: or softkms: URI.",
+	)
+}
+
+func loadPKCS12(filename, password string) (tls.Certificate, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to read PKCS#12 file: %w", err)
+	}
+	key, cert, chain, err := pkcs12.DecodeChain(b, password)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to decode PKCS#12 file %s: %w", filename, err)
+	}
+	return newTLSCertificate(key, append([]*x509.Certificate{cert}, chain...))
+}
+
+// inlineOrFile returns the inline value if set, otherwise the contents of the
+// file. The returned name is used in error messages.
+func inlineOrFile(inline, file types.String, name string) ([]byte, string, error) {
+	switch {
+	case !inline.IsNull() && !file.IsNull():
+		return nil, "", fmt.Errorf("only one of the client certificate %s or %s file may be set", name, name)
+	case !inline.IsNull():
+		return []byte(inline.ValueString()), name, nil
+	case !file.IsNull():
+		b, err := os.ReadFile(file.ValueString())
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read client certificate %s file: %w", name, err)
+		}
+		return b, file.ValueString(), nil
+	default:
+		return nil, "", fmt.Errorf("the client certificate %s or %s file is required", name, name)
+	}
+}
+
+func newTLSCertificate(key any, certs []*x509.Certificate) (tls.Certificate, error) {
+	if len(certs) == 0 {
+		return tls.Certificate{}, errors.New("no client certificate found")
+	}
+	if err := keyutil.VerifyPair(certs[0].PublicKey, key); err != nil {
+		return tls.Certificate{}, fmt.Errorf("client certificate does not match private key: %w", err)
+	}
+
+	tlsCert := tls.Certificate{
+		PrivateKey: key,
+		Leaf:       certs[0],
+	}
+	for _, c := range certs {
+		tlsCert.Certificate = append(tlsCert.Certificate, c.Raw)
+	}
+	return tlsCert, nil
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.step.sm/crypto/keyutil"
+	"go.step.sm/crypto/minica"
+	"go.step.sm/crypto/pemutil"
+	"go.step.sm/crypto/x509util"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestLoadClientCertificate(t *testing.T) {
+	ca, err := minica.New()
+	require.NoError(t, err)
+	signer, err := keyutil.GenerateDefaultSigner()
+	require.NoError(t, err)
+	cr, err := x509util.CreateCertificateRequest("client", nil, signer)
+	require.NoError(t, err)
+	leaf, err := ca.SignCSR(cr)
+	require.NoError(t, err)
+
+	certBlock, err := pemutil.Serialize(leaf)
+	require.NoError(t, err)
+	intermediateBlock, err := pemutil.Serialize(ca.Intermediate)
+	require.NoError(t, err)
+	certPEM := string(pem.EncodeToMemory(certBlock)) + string(pem.EncodeToMemory(intermediateBlock))
+
+	keyBlock, err := pemutil.Serialize(signer)
+	require.NoError(t, err)
+	keyPEM := string(pem.EncodeToMemory(keyBlock))
+
+	encryptedKeyBlock, err := pemutil.Serialize(signer, pemutil.WithPKCS8(true), pemutil.WithPassword([]byte("pass")))
+	require.NoError(t, err)
+
+	p12, err := pkcs12.Modern.WithRand(rand.Reader).Encode(signer, leaf, nil, "p12pass")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	require.NoError(t, os.WriteFile(certFile, []byte(certPEM), 0600))
+	encryptedKeyFile := filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(encryptedKeyFile, pem.EncodeToMemory(encryptedKeyBlock), 0600))
+	p12File := filepath.Join(dir, "client.p12")
+	require.NoError(t, os.WriteFile(p12File, p12, 0600))
+
+	keyFile := filepath.Join(dir, "plain.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(keyPEM), 0600))
+
+	_, otherKey, err := keyutil.GenerateDefaultKeyPair()
+	require.NoError(t, err)
+	otherKeyBlock, err := pemutil.Serialize(otherKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		model     ClientCertificateModel
+		wantChain int
+		wantErr   string
+	}{
+		{
+			name: "inline",
+			model: ClientCertificateModel{
+				Certificate: types.StringValue(certPEM),
+				PrivateKey:  types.StringValue(keyPEM),
+			},
+			wantChain: 2,
+		},
+		{
+			name: "files with encrypted PKCS#8 key",
+			model: ClientCertificateModel{
+				CertificateFile:    types.StringValue(certFile),
+				PrivateKeyFile:     types.StringValue(encryptedKeyFile),
+				PrivateKeyPassword: types.StringValue("pass"),
+			},
+			wantChain: 2,
+		},
+		{
+			name: "encrypted key without password",
+			model: ClientCertificateModel{
+				CertificateFile: types.StringValue(certFile),
+				PrivateKeyFile:  types.StringValue(encryptedKeyFile),
+			},
+			wantErr: "password protected",
+		},
+		{
+			name: "softkms uri",
+			model: ClientCertificateModel{
+				Certificate:   types.StringValue(certPEM),
+				PrivateKeyURI: types.StringValue("softkms:path=" + keyFile),
+			},
+			wantChain: 2,
+		},
+		{
+			name: "softkms uri with encrypted key",
+			model: ClientCertificateModel{
+				Certificate:        types.StringValue(certPEM),
+				PrivateKeyURI:      types.StringValue("softkms:path=" + encryptedKeyFile),
+				PrivateKeyPassword: types.StringValue("pass"),
+			},
+			wantChain: 2,
+		},
+		{
+			name: "uri and private key",
+			model: ClientCertificateModel{
+				Certificate:   types.StringValue(certPEM),
+				PrivateKey:    types.StringValue(keyPEM),
+				PrivateKeyURI: types.StringValue("softkms:path=" + keyFile),
+			},
+			wantErr: "cannot be combined",
+		},
+		{
+			name: "pkcs12",
+			model: ClientCertificateModel{
+				PKCS12File:     types.StringValue(p12File),
+				PKCS12Password: types.StringValue("p12pass"),
+			},
+			wantChain: 1,
+		},
+		{
+			name: "pkcs12 wrong password",
+			model: ClientCertificateModel{
+				PKCS12File:     types.StringValue(p12File),
+				PKCS12Password: types.StringValue("wrong"),
+			},
+			wantErr: "failed to decode PKCS#12",
+		},
+		{
+			name: "mismatched key",
+			model: ClientCertificateModel{
+				Certificate: types.StringValue(certPEM),
+				PrivateKey:  types.StringValue(string(pem.EncodeToMemory(otherKeyBlock))),
+			},
+			wantErr: "does not match",
+		},
+		{
+			name: "missing key",
+			model: ClientCertificateModel{
+				Certificate: types.StringValue(certPEM),
+			},
+			wantErr: "private key or private key file is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tlsCert, err := loadClientCertificate(t.Context(), &tc.model)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, tlsCert.Certificate, tc.wantChain)
+			assert.Equal(t, leaf.Raw, tlsCert.Certificate[0])
+		})
+	}
+}
+
+func TestPrivateKeyURIValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     types.String
+		wantErr bool
+	}{
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+		{"softkms", types.StringValue("softkms:path=client.key"), false},
+		{"awskms", types.StringValue("awskms:key-id=1234"), false},
+		{"pkcs11", types.StringValue("pkcs11:module-path=/usr/lib/libykcs11.so;token=YubiKey;id=01"), !pkcs11Supported},
+		{"PKCS11", types.StringValue("PKCS11:token=YubiKey;id=01"), !pkcs11Supported},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("client_certificate").AtName("private_key_uri"),
+				ConfigValue: tc.uri,
+			}
+			resp := &validator.StringResponse{}
+			privateKeyURIValidator{}.ValidateString(context.Background(), req, resp)
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
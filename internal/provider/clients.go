@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+)
+
+// tokenSource provides the bearer token sent with every API request.
+type tokenSource interface {
+	Token(ctx context.Context) (string, error)
+	// Invalidate is called when the API rejects a token so that the next call
+	// to Token returns a fresh one. It returns false if the token was not
+	// discarded, either because it can't be refreshed or because it was
+	// already replaced.
+	Invalidate(token string) bool
+}
+
+// staticTokenSource is a token configured directly on the provider. It cannot
+// be refreshed.
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+func (s staticTokenSource) Invalidate(string) bool {
+	return false
+}
+
+// tokenTransport sets the Authorization header from its token source. If the
+// API responds with 401 the token is invalidated and the request is retried
+// once with a fresh token.
+type tokenTransport struct {
+	source tokenSource
+	base   http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	tkn, err := t.source.Token(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(withBearerToken(r, tkn))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The request can only be retried if its body can be replayed.
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return resp, nil
+	}
+
+	var fresh string
+	if !t.source.Invalidate(tkn) {
+		// Another request may have replaced the token already, in which case
+		// the current token is returned without a new request.
+		fresh, err = t.source.Token(r.Context())
+		if err != nil || fresh == tkn {
+			return resp, nil
+		}
+	}
+
+	// Release the rejected response first since getting a new token may need
+	// its connection or in-flight slot.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+
+	if fresh == "" {
+		fresh, err = t.source.Token(r.Context())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	retry := withBearerToken(r, fresh)
+	if r.GetBody != nil {
+		retry.Body, err = r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t.base.RoundTrip(retry)
+}
+
+func withBearerToken(r *http.Request, tkn string) *http.Request {
+	r2 := r.Clone(r.Context())
+	r2.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tkn))
+	return r2
+}
+
+// newClientset returns clients for every API version that share the same HTTP
+// client. The pinned client requests apiVersion.
+func newClientset(server, apiVersion string, httpClient *http.Client) (*clientset.Clients, error) {
+	client20250101, err := v20250101.NewClient(server, v20250101.WithHTTPClient(httpClient), v20250101.WithRequestEditorFn(v20250101.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", clientset.Version20250101)
+		return nil
+	})))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Smallstep API client (2025-01-01): %w", err)
+	}
+
+	client20260501, err := v20260501.NewClient(server, v20260501.WithHTTPClient(httpClient), v20260501.WithRequestEditorFn(v20260501.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", clientset.Version20260501)
+		return nil
+	})))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Smallstep API client (2026-05-01): %w", err)
+	}
+
+	pinned, err := v20260501.NewClient(server, v20260501.WithHTTPClient(httpClient), v20260501.WithRequestEditorFn(v20260501.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", apiVersion)
+		return nil
+	})))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Smallstep API client (%s): %w", apiVersion, err)
+	}
+
+	return &clientset.Clients{
+		V20250101:  client20250101,
+		V20260501:  client20260501,
+		Pinned:     pinned,
+		APIVersion: apiVersion,
+	}, nil
+}
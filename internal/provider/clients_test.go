@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/fakeapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNewClientset_pinned(t *testing.T) {
+	srv := fakeapi.New()
+	t.Cleanup(srv.Close)
+
+	for _, apiVersion := range []string{clientset.Version20250101, clientset.Version20260501} {
+		t.Run(apiVersion, func(t *testing.T) {
+			var sent []string
+			httpClient := &http.Client{Transport: &tokenTransport{
+				source: staticTokenSource(fakeapi.Token),
+				base: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					sent = append(sent, r.Header.Get("X-Smallstep-Api-Version"))
+					return http.DefaultTransport.RoundTrip(r)
+				}),
+			}}
+
+			clients, err := newClientset(srv.URL, apiVersion, httpClient)
+			require.NoError(t, err)
+			assert.Equal(t, apiVersion, clients.APIVersion)
+
+			resp, err := clients.Pinned.GetAuthorities(context.Background(), &v20260501.GetAuthoritiesParams{})
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			resp, err = clients.V20250101.GetAuthorities(context.Background(), nil)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			resp, err = clients.V20260501.GetAuthorities(context.Background(), nil)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, []string{apiVersion, clientset.Version20250101, clientset.Version20260501}, sent)
+		})
+	}
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -23,7 +23,7 @@ func NewDataSource() datasource.DataSource {
 }
 
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (ds *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,11 +47,11 @@ func (ds *DataSource) Configure(ctx context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	ds.client = clients.V20250101
+	ds.client = clients.Pinned
 }
 
 func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	credential, props, err := utils.Describe("credential")
+	credential, props, err := utils.DescribeV20260501("credential")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Schema",
@@ -60,7 +60,7 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 		return
 	}
 
-	cert, certProps, err := utils.Describe("credentialCertificate")
+	cert, certProps, err := utils.DescribeV20260501("credentialCertificate")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Certificate Schema",
@@ -69,7 +69,7 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 		return
 	}
 
-	policy, policyProps, err := utils.Describe("policyMatchCriteria")
+	policy, policyProps, err := utils.DescribeV20260501("policyMatchCriteria")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Device Policy Schema",
@@ -78,7 +78,7 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 		return
 	}
 
-	files, filesProps, err := utils.Describe("credentialFiles")
+	files, filesProps, err := utils.DescribeV20260501("credentialFiles")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Files Schema",
@@ -87,7 +87,7 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 		return
 	}
 
-	x509, _, err := utils.Describe("x509Fields")
+	x509, x509Props, err := utils.DescribeV20260501("x509Fields")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI X509 Certificate Schema",
@@ -96,7 +96,7 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 		return
 	}
 
-	_, certFieldProps, err := utils.Describe("certificateField")
+	_, certFieldProps, err := utils.DescribeV20260501("certificateField")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Certificate Field Schema",
@@ -105,7 +105,7 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 		return
 	}
 
-	_, certFieldListProps, err := utils.Describe("certificateFieldList")
+	_, certFieldListProps, err := utils.DescribeV20260501("certificateFieldList")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Certificate Field List Schema",
@@ -141,10 +141,66 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"insecure_include_requested": schema.BoolAttribute{
+				MarkdownDescription: certFieldListProps["insecureIncludeRequested"],
+				Computed:            true,
+			},
 		},
 	}
 
-	key, keyProps, err := utils.Describe("credentialKey")
+	_, typedSANsProps, err := utils.DescribeV20260501("x509TypedSANs")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Smallstep OpenAPI X509 Typed SANs Schema",
+			err.Error(),
+		)
+		return
+	}
+
+	_, extensionProps, err := utils.DescribeV20260501("x509CustomExtension")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Smallstep OpenAPI X509 Custom Extension Schema",
+			err.Error(),
+		)
+		return
+	}
+
+	namePolicy, namePolicyProps, err := utils.DescribeV20260501("x509NamePolicy")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Smallstep OpenAPI X509 Name Policy Schema",
+			err.Error(),
+		)
+		return
+	}
+
+	names := func(description string) schema.SingleNestedAttribute {
+		list := func() schema.ListAttribute {
+			return schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			}
+		}
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"common_names": list(),
+				"dns":          list(),
+				"emails":       list(),
+				"ips":          list(),
+				"uris":         list(),
+			},
+		}
+	}
+
+	describe := func(a schema.SingleNestedAttribute, description string) schema.SingleNestedAttribute {
+		a.MarkdownDescription = description
+		return a
+	}
+
+	key, keyProps, err := utils.DescribeV20260501("credentialKey")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Key Info Schema",
@@ -165,6 +221,10 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 				MarkdownDescription: props["slug"],
 				Computed:            true,
 			},
+			"management_mode": schema.StringAttribute{
+				MarkdownDescription: props["managementMode"],
+				Computed:            true,
+			},
 			"certificate": schema.SingleNestedAttribute{
 				MarkdownDescription: cert,
 				Computed:            true,
@@ -182,6 +242,57 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 							"province":            nameList,
 							"street_address":      nameList,
 							"postal_code":         nameList,
+							"given_name":          describe(name, x509Props["givenName"]),
+							"surname":             describe(name, x509Props["surname"]),
+							"serial_number":       describe(name, x509Props["serialNumber"]),
+							"typed_sans": schema.SingleNestedAttribute{
+								MarkdownDescription: x509Props["typedSans"],
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+									"dns_names":            describe(nameList, typedSANsProps["dnsNames"]),
+									"email_addresses":      describe(nameList, typedSANsProps["emailAddresses"]),
+									"ip_addresses":         describe(nameList, typedSANsProps["ipAddresses"]),
+									"uris":                 describe(nameList, typedSANsProps["uris"]),
+									"user_principal_names": describe(nameList, typedSANsProps["userPrincipalNames"]),
+								},
+							},
+							"extended_key_usage": schema.ListAttribute{
+								MarkdownDescription: x509Props["extendedKeyUsage"],
+								ElementType:         types.StringType,
+								Computed:            true,
+							},
+							"custom_extensions": schema.ListNestedAttribute{
+								MarkdownDescription: x509Props["customExtensions"],
+								Computed:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"oid": schema.StringAttribute{
+											MarkdownDescription: extensionProps["oid"],
+											Computed:            true,
+										},
+										"value": schema.StringAttribute{
+											MarkdownDescription: extensionProps["value"],
+											Computed:            true,
+										},
+										"critical": schema.BoolAttribute{
+											MarkdownDescription: extensionProps["critical"],
+											Computed:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+					"name_policy": schema.SingleNestedAttribute{
+						MarkdownDescription: namePolicy,
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"allow": names(namePolicyProps["allow"]),
+							"deny":  names(namePolicyProps["deny"]),
+							"allow_wildcard_names": schema.BoolAttribute{
+								MarkdownDescription: namePolicyProps["allowWildcardNames"],
+								Computed:            true,
+							},
 						},
 					},
 					"duration": schema.StringAttribute{
@@ -210,6 +321,14 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 						Computed:            true,
 						MarkdownDescription: keyProps["protection"],
 					},
+					"store": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: keyProps["store"],
+					},
+					"compatibility": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: keyProps["compatibility"],
+					},
 				},
 			},
 			"policy": schema.SingleNestedAttribute{
@@ -282,6 +401,8 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 }
 
 func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+name, "read")
+
 	var id string
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
@@ -289,7 +410,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	httpResp, err := ds.client.GetCredential(ctx, id, &v20250101.GetCredentialParams{})
+	httpResp, err := ds.client.GetCredential(ctx, id, &v20260501.GetCredentialParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -312,7 +433,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	credential := &v20250101.Credential{}
+	credential := &v20260501.Credential{}
 	if err := json.NewDecoder(httpResp.Body).Decode(credential); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
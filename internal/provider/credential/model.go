@@ -2,6 +2,8 @@ package credential
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -9,31 +11,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
 const name = "smallstep_credential"
 
 type CredentialModel struct {
-	ID          types.String `tfsdk:"id"`
-	Slug        types.String `tfsdk:"slug"`
-	Certificate types.Object `tfsdk:"certificate"`
-	Key         types.Object `tfsdk:"key"`
-	Policy      types.Object `tfsdk:"policy"`
-	Files       types.Object `tfsdk:"files"`
+	ID             types.String `tfsdk:"id"`
+	Slug           types.String `tfsdk:"slug"`
+	ManagementMode types.String `tfsdk:"management_mode"`
+	Certificate    types.Object `tfsdk:"certificate"`
+	Key            types.Object `tfsdk:"key"`
+	Policy         types.Object `tfsdk:"policy"`
+	Files          types.Object `tfsdk:"files"`
 }
 
 type CertificateModel struct {
 	AuthorityID types.String `tfsdk:"authority_id"`
 	Duration    types.String `tfsdk:"duration"`
 	X509        types.Object `tfsdk:"x509"`
+	NamePolicy  types.Object `tfsdk:"name_policy"`
 }
 
 var certificateAttributes = map[string]attr.Type{
 	"authority_id": types.StringType,
 	"duration":     types.StringType,
 	"x509":         types.ObjectType{AttrTypes: x509Attributes},
+	"name_policy":  types.ObjectType{AttrTypes: namePolicyAttributes},
 }
 
 type X509Model struct {
@@ -46,6 +52,12 @@ type X509Model struct {
 	StreetAddress      types.Object `tfsdk:"street_address"`
 	PostalCode         types.Object `tfsdk:"postal_code"`
 	Country            types.Object `tfsdk:"country"`
+	GivenName          types.Object `tfsdk:"given_name"`
+	Surname            types.Object `tfsdk:"surname"`
+	SerialNumber       types.Object `tfsdk:"serial_number"`
+	TypedSANs          types.Object `tfsdk:"typed_sans"`
+	ExtendedKeyUsage   types.List   `tfsdk:"extended_key_usage"`
+	CustomExtensions   types.List   `tfsdk:"custom_extensions"`
 }
 
 var x509Attributes = map[string]attr.Type{
@@ -58,18 +70,84 @@ var x509Attributes = map[string]attr.Type{
 	"street_address":      types.ObjectType{AttrTypes: certificateFieldListAttributes},
 	"postal_code":         types.ObjectType{AttrTypes: certificateFieldListAttributes},
 	"country":             types.ObjectType{AttrTypes: certificateFieldListAttributes},
+	"given_name":          types.ObjectType{AttrTypes: certificateFieldAttributes},
+	"surname":             types.ObjectType{AttrTypes: certificateFieldAttributes},
+	"serial_number":       types.ObjectType{AttrTypes: certificateFieldAttributes},
+	"typed_sans":          types.ObjectType{AttrTypes: typedSANsAttributes},
+	"extended_key_usage":  types.ListType{ElemType: types.StringType},
+	"custom_extensions":   types.ListType{ElemType: types.ObjectType{AttrTypes: customExtensionAttributes}},
+}
+
+type TypedSANsModel struct {
+	DNSNames           types.Object `tfsdk:"dns_names"`
+	EmailAddresses     types.Object `tfsdk:"email_addresses"`
+	IPAddresses        types.Object `tfsdk:"ip_addresses"`
+	URIs               types.Object `tfsdk:"uris"`
+	UserPrincipalNames types.Object `tfsdk:"user_principal_names"`
+}
+
+var typedSANsAttributes = map[string]attr.Type{
+	"dns_names":            types.ObjectType{AttrTypes: certificateFieldListAttributes},
+	"email_addresses":      types.ObjectType{AttrTypes: certificateFieldListAttributes},
+	"ip_addresses":         types.ObjectType{AttrTypes: certificateFieldListAttributes},
+	"uris":                 types.ObjectType{AttrTypes: certificateFieldListAttributes},
+	"user_principal_names": types.ObjectType{AttrTypes: certificateFieldListAttributes},
+}
+
+type CustomExtensionModel struct {
+	OID      types.String `tfsdk:"oid"`
+	Value    types.String `tfsdk:"value"`
+	Critical types.Bool   `tfsdk:"critical"`
+}
+
+var customExtensionAttributes = map[string]attr.Type{
+	"oid":      types.StringType,
+	"value":    types.StringType,
+	"critical": types.BoolType,
+}
+
+type NamePolicyModel struct {
+	Allow              types.Object `tfsdk:"allow"`
+	Deny               types.Object `tfsdk:"deny"`
+	AllowWildcardNames types.Bool   `tfsdk:"allow_wildcard_names"`
+}
+
+var namePolicyAttributes = map[string]attr.Type{
+	"allow":                types.ObjectType{AttrTypes: namesAttributes},
+	"deny":                 types.ObjectType{AttrTypes: namesAttributes},
+	"allow_wildcard_names": types.BoolType,
+}
+
+type NamesModel struct {
+	CommonNames types.List `tfsdk:"common_names"`
+	DNS         types.List `tfsdk:"dns"`
+	Emails      types.List `tfsdk:"emails"`
+	IPs         types.List `tfsdk:"ips"`
+	URIs        types.List `tfsdk:"uris"`
+}
+
+var namesAttributes = map[string]attr.Type{
+	"common_names": types.ListType{ElemType: types.StringType},
+	"dns":          types.ListType{ElemType: types.StringType},
+	"emails":       types.ListType{ElemType: types.StringType},
+	"ips":          types.ListType{ElemType: types.StringType},
+	"uris":         types.ListType{ElemType: types.StringType},
 }
 
 type KeyModel struct {
-	Type       types.String `tfsdk:"type"`
-	Protection types.String `tfsdk:"protection"`
-	PubFile    types.String `tfsdk:"pub_file"`
+	Type          types.String `tfsdk:"type"`
+	Protection    types.String `tfsdk:"protection"`
+	PubFile       types.String `tfsdk:"pub_file"`
+	Store         types.String `tfsdk:"store"`
+	Compatibility types.String `tfsdk:"compatibility"`
 }
 
 var keyAttributes = map[string]attr.Type{
-	"type":       types.StringType,
-	"protection": types.StringType,
-	"pub_file":   types.StringType,
+	"type":          types.StringType,
+	"protection":    types.StringType,
+	"pub_file":      types.StringType,
+	"store":         types.StringType,
+	"compatibility": types.StringType,
 }
 
 type FilesModel struct {
@@ -155,30 +233,52 @@ var certificateFieldAttributes = map[string]attr.Type{
 }
 
 type CertificateFieldListModel struct {
-	Static         types.List `tfsdk:"static"`
-	DeviceMetadata types.List `tfsdk:"device_metadata"`
+	Static                   types.List `tfsdk:"static"`
+	DeviceMetadata           types.List `tfsdk:"device_metadata"`
+	InsecureIncludeRequested types.Bool `tfsdk:"insecure_include_requested"`
 }
 
 var certificateFieldListAttributes = map[string]attr.Type{
-	"static":          types.ListType{ElemType: types.StringType},
-	"device_metadata": types.ListType{ElemType: types.StringType},
-}
-
-func (k *KeyModel) toAPI() v20250101.CredentialKey {
-	return v20250101.CredentialKey{
-		Type:       (*v20250101.CredentialKeyType)(k.Type.ValueStringPointer()),
-		Protection: (*v20250101.CredentialKeyProtection)(k.Protection.ValueStringPointer()),
-		PubFile:    k.PubFile.ValueStringPointer(),
+	"static":                     types.ListType{ElemType: types.StringType},
+	"device_metadata":            types.ListType{ElemType: types.StringType},
+	"insecure_include_requested": types.BoolType,
+}
+
+// certificateFieldLists are the x509 attributes of schema version 1 that are
+// certificate field lists.
+var certificateFieldLists = []string{
+	"sans",
+	"organization",
+	"organizational_unit",
+	"locality",
+	"country",
+	"province",
+	"street_address",
+	"postal_code",
+}
+
+func (k *KeyModel) toAPI() v20260501.CredentialKey {
+	return v20260501.CredentialKey{
+		Type:          (*v20260501.CredentialKeyType)(k.Type.ValueStringPointer()),
+		Protection:    (*v20260501.CredentialKeyProtection)(k.Protection.ValueStringPointer()),
+		PubFile:       k.PubFile.ValueStringPointer(),
+		Store:         (*v20260501.CredentialKeyStore)(k.Store.ValueStringPointer()),
+		Compatibility: (*v20260501.CredentialKeyCompatibility)(k.Compatibility.ValueStringPointer()),
 	}
 }
 
-func (m *CertificateModel) toAPI(ctx context.Context, diags *diag.Diagnostics) v20250101.CredentialCertificate {
-	cert := v20250101.CredentialCertificate{
-		Type:        v20250101.CredentialCertificateTypeX509,
+func (m *CertificateModel) toAPI(ctx context.Context, diags *diag.Diagnostics, apiVersion string) v20260501.CredentialCertificate {
+	cert := v20260501.CredentialCertificate{
+		Type:        v20260501.CredentialCertificateTypeX509,
 		AuthorityID: m.AuthorityID.ValueString(),
+		Duration:    m.Duration.ValueStringPointer(),
 	}
 
-	cert.Duration = m.Duration.ValueString()
+	// The 2025-01-01 API requires the duration and defaults an empty one to
+	// 24h.
+	if apiVersion == clientset.Version20250101 {
+		cert.Duration = utils.Ref(m.Duration.ValueString())
+	}
 
 	if !m.X509.IsNull() && !m.X509.IsUnknown() {
 		x509 := &X509Model{}
@@ -192,27 +292,37 @@ func (m *CertificateModel) toAPI(ctx context.Context, diags *diag.Diagnostics) v
 		}
 	}
 
+	if !m.NamePolicy.IsNull() && !m.NamePolicy.IsUnknown() {
+		policy := &NamePolicyModel{}
+		diags.Append(m.NamePolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})...)
+		cert.NamePolicy = &v20260501.X509NamePolicy{
+			Allow:              asNames(ctx, diags, policy.Allow),
+			Deny:               asNames(ctx, diags, policy.Deny),
+			AllowWildcardNames: policy.AllowWildcardNames.ValueBoolPointer(),
+		}
+	}
+
 	return cert
 }
 
-func (m *FilesModel) toAPI() *v20250101.CredentialFiles {
+func (m *FilesModel) toAPI() *v20260501.CredentialFiles {
 	if m == nil {
 		return nil
 	}
 
-	return &v20250101.CredentialFiles{
+	return &v20260501.CredentialFiles{
 		RootFile:  m.RootFile.ValueStringPointer(),
 		CrtFile:   m.CrtFile.ValueStringPointer(),
 		KeyFile:   m.KeyFile.ValueStringPointer(),
-		KeyFormat: (*v20250101.CredentialFilesKeyFormat)(m.KeyFormat.ValueStringPointer()),
+		KeyFormat: (*v20260501.CredentialFilesKeyFormat)(m.KeyFormat.ValueStringPointer()),
 		Uid:       utils.ToIntPointer(m.UID.ValueInt64Pointer()),
 		Gid:       utils.ToIntPointer(m.GID.ValueInt64Pointer()),
 		Mode:      utils.ToIntPointer(m.Mode.ValueInt64Pointer()),
 	}
 }
 
-func (x509 *X509Model) toAPI(ctx context.Context, diags *diag.Diagnostics) v20250101.X509Fields {
-	return v20250101.X509Fields{
+func (x509 *X509Model) toAPI(ctx context.Context, diags *diag.Diagnostics) v20260501.X509Fields {
+	return v20260501.X509Fields{
 		CommonName:         asCertificateField(ctx, diags, x509.CommonName),
 		Sans:               asCertificateFieldList(ctx, diags, x509.SANs),
 		Country:            asCertificateFieldList(ctx, diags, x509.Country),
@@ -222,15 +332,97 @@ func (x509 *X509Model) toAPI(ctx context.Context, diags *diag.Diagnostics) v2025
 		PostalCode:         asCertificateFieldList(ctx, diags, x509.PostalCode),
 		Province:           asCertificateFieldList(ctx, diags, x509.Province),
 		StreetAddress:      asCertificateFieldList(ctx, diags, x509.StreetAddress),
+		GivenName:          asCertificateField(ctx, diags, x509.GivenName),
+		Surname:            asCertificateField(ctx, diags, x509.Surname),
+		SerialNumber:       asCertificateField(ctx, diags, x509.SerialNumber),
+		TypedSans:          asTypedSANs(ctx, diags, x509.TypedSANs),
+		ExtendedKeyUsage:   asExtendedKeyUsage(ctx, diags, x509.ExtendedKeyUsage),
+		CustomExtensions:   asCustomExtensions(ctx, diags, x509.CustomExtensions),
+	}
+}
+
+func asTypedSANs(ctx context.Context, diags *diag.Diagnostics, obj types.Object) *v20260501.X509TypedSANs {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	model := &TypedSANsModel{}
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	return &v20260501.X509TypedSANs{
+		DnsNames:           asCertificateFieldList(ctx, diags, model.DNSNames),
+		EmailAddresses:     asCertificateFieldList(ctx, diags, model.EmailAddresses),
+		IpAddresses:        asCertificateFieldList(ctx, diags, model.IPAddresses),
+		Uris:               asCertificateFieldList(ctx, diags, model.URIs),
+		UserPrincipalNames: asCertificateFieldList(ctx, diags, model.UserPrincipalNames),
+	}
+}
+
+func asExtendedKeyUsage(ctx context.Context, diags *diag.Diagnostics, list types.List) *[]v20260501.X509ExtendedKeyUsage {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
 	}
+
+	var usages []string
+	diags.Append(list.ElementsAs(ctx, &usages, false)...)
+
+	eku := make([]v20260501.X509ExtendedKeyUsage, len(usages))
+	for i, usage := range usages {
+		eku[i] = v20260501.X509ExtendedKeyUsage(usage)
+	}
+	return &eku
 }
 
-func (p *PolicyModel) toAPI(ctx context.Context, diags *diag.Diagnostics) *v20250101.PolicyMatchCriteria {
+func asCustomExtensions(ctx context.Context, diags *diag.Diagnostics, list types.List) *[]v20260501.X509CustomExtension {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var models []CustomExtensionModel
+	diags.Append(list.ElementsAs(ctx, &models, false)...)
+
+	extensions := make([]v20260501.X509CustomExtension, len(models))
+	for i, model := range models {
+		value, err := base64.StdEncoding.DecodeString(model.Value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("certificate").AtName("x509").AtName("custom_extensions").AtListIndex(i).AtName("value"),
+				"Invalid Custom Extension Value",
+				fmt.Sprintf("The value must be base64-encoded: %v", err),
+			)
+		}
+		extensions[i] = v20260501.X509CustomExtension{
+			Oid:      model.OID.ValueString(),
+			Value:    value,
+			Critical: model.Critical.ValueBoolPointer(),
+		}
+	}
+	return &extensions
+}
+
+func asNames(ctx context.Context, diags *diag.Diagnostics, obj types.Object) *v20260501.X509Names {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	model := &NamesModel{}
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	names := &v20260501.X509Names{}
+	diags.Append(model.CommonNames.ElementsAs(ctx, &names.CommonNames, false)...)
+	diags.Append(model.DNS.ElementsAs(ctx, &names.Dns, false)...)
+	diags.Append(model.Emails.ElementsAs(ctx, &names.Emails, false)...)
+	diags.Append(model.IPs.ElementsAs(ctx, &names.Ips, false)...)
+	diags.Append(model.URIs.ElementsAs(ctx, &names.Uris, false)...)
+	return names
+}
+
+func (p *PolicyModel) toAPI(ctx context.Context, diags *diag.Diagnostics) *v20260501.PolicyMatchCriteria {
 	if p == nil {
 		return nil
 	}
 
-	policy := &v20250101.PolicyMatchCriteria{}
+	policy := &v20260501.PolicyMatchCriteria{}
 
 	if len(p.Assurance.Elements()) > 0 {
 		diags.Append(p.Assurance.ElementsAs(ctx, &policy.Assurance, false)...)
@@ -251,27 +443,28 @@ func (p *PolicyModel) toAPI(ctx context.Context, diags *diag.Diagnostics) *v2025
 	return policy
 }
 
-func (cf *CertificateFieldModel) toAPI() *v20250101.CertificateField {
-	return &v20250101.CertificateField{
+func (cf *CertificateFieldModel) toAPI() *v20260501.CertificateField {
+	return &v20260501.CertificateField{
 		Static:         cf.Static.ValueStringPointer(),
 		DeviceMetadata: cf.DeviceMetadata.ValueStringPointer(),
 	}
 }
 
-func (cfl *CertificateFieldListModel) toAPI(ctx context.Context, diags *diag.Diagnostics) *v20250101.CertificateFieldList {
+func (cfl *CertificateFieldListModel) toAPI(ctx context.Context, diags *diag.Diagnostics) *v20260501.CertificateFieldList {
 	var static *[]string
 	var deviceMetadata *[]string
 
 	diags.Append(cfl.Static.ElementsAs(ctx, &static, false)...)
 	diags.Append(cfl.DeviceMetadata.ElementsAs(ctx, &deviceMetadata, false)...)
 
-	return &v20250101.CertificateFieldList{
-		Static:         static,
-		DeviceMetadata: deviceMetadata,
+	return &v20260501.CertificateFieldList{
+		Static:                   static,
+		DeviceMetadata:           deviceMetadata,
+		InsecureIncludeRequested: cfl.InsecureIncludeRequested.ValueBoolPointer(),
 	}
 }
 
-func asCertificateFieldList(ctx context.Context, diags *diag.Diagnostics, obj types.Object) *v20250101.CertificateFieldList {
+func asCertificateFieldList(ctx context.Context, diags *diag.Diagnostics, obj types.Object) *v20260501.CertificateFieldList {
 	if obj.IsNull() || obj.IsUnknown() {
 		return nil
 	}
@@ -283,7 +476,7 @@ func asCertificateFieldList(ctx context.Context, diags *diag.Diagnostics, obj ty
 	return model.toAPI(ctx, diags)
 }
 
-func asCertificateField(ctx context.Context, diags *diag.Diagnostics, obj types.Object) *v20250101.CertificateField {
+func asCertificateField(ctx context.Context, diags *diag.Diagnostics, obj types.Object) *v20260501.CertificateField {
 	if obj.IsNull() || obj.IsUnknown() {
 		return nil
 	}
@@ -295,7 +488,7 @@ func asCertificateField(ctx context.Context, diags *diag.Diagnostics, obj types.
 	return model.toAPI()
 }
 
-func toAPI(ctx context.Context, diags *diag.Diagnostics, model *CredentialModel) v20250101.Credential {
+func toAPI(ctx context.Context, diags *diag.Diagnostics, model *CredentialModel, apiVersion string) v20260501.Credential {
 	cert := CertificateModel{}
 	ds := model.Certificate.As(ctx, &cert, basetypes.ObjectAsOptions{})
 	diags.Append(ds...)
@@ -312,29 +505,34 @@ func toAPI(ctx context.Context, diags *diag.Diagnostics, model *CredentialModel)
 	ds = model.Files.As(ctx, &files, basetypes.ObjectAsOptions{})
 	diags.Append(ds...)
 
-	return v20250101.Credential{
-		Id:          model.ID.ValueStringPointer(),
-		Slug:        model.Slug.ValueString(),
-		Certificate: cert.toAPI(ctx, diags),
-		Key:         key.toAPI(),
-		Policy:      policy.toAPI(ctx, diags),
-		Files:       files.toAPI(),
+	return v20260501.Credential{
+		Id:             model.ID.ValueStringPointer(),
+		Slug:           model.Slug.ValueString(),
+		ManagementMode: (*v20260501.EndpointManagementMode)(model.ManagementMode.ValueStringPointer()),
+		Certificate:    cert.toAPI(ctx, diags, apiVersion),
+		Key:            key.toAPI(),
+		Policy:         policy.toAPI(ctx, diags),
+		Files:          files.toAPI(),
 	}
 }
 
-func fromAPI(ctx context.Context, diags *diag.Diagnostics, credential *v20250101.Credential, state utils.AttributeGetter) CredentialModel {
+func fromAPI(ctx context.Context, diags *diag.Diagnostics, credential *v20260501.Credential, state utils.AttributeGetter) CredentialModel {
+	managementMode, d := utils.ToOptionalString(ctx, credential.ManagementMode, state, path.Root("management_mode"))
+	diags.Append(d...)
+
 	return CredentialModel{
-		ID:          types.StringPointerValue(credential.Id),
-		Slug:        types.StringValue(credential.Slug),
-		Certificate: certificateObjectFromAPI(ctx, diags, credential.Certificate, state),
-		Key:         keyObjectFromAPI(ctx, diags, credential.Key, state),
-		Policy:      policyObjectFromAPI(ctx, diags, credential.Policy, state),
-		Files:       filesObjectFromAPI(ctx, diags, credential.Files, state),
+		ID:             types.StringPointerValue(credential.Id),
+		Slug:           types.StringValue(credential.Slug),
+		ManagementMode: managementMode,
+		Certificate:    certificateObjectFromAPI(ctx, diags, credential.Certificate, state),
+		Key:            keyObjectFromAPI(ctx, diags, credential.Key, state),
+		Policy:         policyObjectFromAPI(ctx, diags, credential.Policy, state),
+		Files:          filesObjectFromAPI(ctx, diags, credential.Files, state),
 	}
 }
 
-func certificateObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, cert v20250101.CredentialCertificate, state utils.AttributeGetter) types.Object {
-	dur, d := utils.ToEqualString(ctx, &cert.Duration, state, path.Root("certificate").AtName("duration"), utils.IsDurationEqual)
+func certificateObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, cert v20260501.CredentialCertificate, state utils.AttributeGetter) types.Object {
+	dur, d := utils.ToEqualString(ctx, cert.Duration, state, path.Root("certificate").AtName("duration"), utils.IsDurationEqual)
 	diags.Append(d...)
 
 	x509Obj := basetypes.NewObjectNull(x509Attributes)
@@ -349,16 +547,17 @@ func certificateObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, cert
 		"duration":     dur,
 		"x509":         x509Obj,
 		"authority_id": types.StringValue(cert.AuthorityID),
+		"name_policy":  namePolicyObjectFromAPI(ctx, diags, cert.NamePolicy, state),
 	})
 	diags.Append(d...)
 
 	return out
 }
 
-func filesObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, files *v20250101.CredentialFiles, state utils.AttributeGetter) types.Object {
+func filesObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, files *v20260501.CredentialFiles, state utils.AttributeGetter) types.Object {
 	p := path.Root("files")
 
-	if files == nil || reflect.DeepEqual(files, new(v20250101.CredentialFiles)) {
+	if files == nil || reflect.DeepEqual(files, new(v20260501.CredentialFiles)) {
 		// See comments in policyObjectFromAPI regarding empty objects.
 		obj := &FilesModel{}
 		d := state.GetAttribute(ctx, path.Root("files"), &obj)
@@ -420,8 +619,8 @@ func filesObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, files *v20
 	return obj
 }
 
-func policyObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, policy *v20250101.PolicyMatchCriteria, state utils.AttributeGetter) types.Object {
-	if policy == nil || reflect.DeepEqual(policy, new(v20250101.PolicyMatchCriteria)) {
+func policyObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, policy *v20260501.PolicyMatchCriteria, state utils.AttributeGetter) types.Object {
+	if policy == nil || reflect.DeepEqual(policy, new(v20260501.PolicyMatchCriteria)) {
 		// Users can set non-null empty policies in terraform config, such as
 		// `policy = {}` or `policy = { assurance = [] }`.
 		// The API will return a nil policy object for all of these, but
@@ -487,7 +686,7 @@ func policyObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, policy *v
 	return obj
 }
 
-func certificateFieldObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, cf *v20250101.CertificateField, state utils.AttributeGetter, p path.Path) types.Object {
+func certificateFieldObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, cf *v20260501.CertificateField, state utils.AttributeGetter, p path.Path) types.Object {
 	if cf == nil {
 		return basetypes.NewObjectNull(certificateFieldAttributes)
 	}
@@ -507,7 +706,7 @@ func certificateFieldObjectFromAPI(ctx context.Context, diags *diag.Diagnostics,
 	return obj
 }
 
-func certificateFieldListObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, cfl *v20250101.CertificateFieldList, state utils.AttributeGetter, p path.Path) types.Object {
+func certificateFieldListObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, cfl *v20260501.CertificateFieldList, state utils.AttributeGetter, p path.Path) types.Object {
 	if cfl == nil {
 		return basetypes.NewObjectNull(certificateFieldListAttributes)
 	}
@@ -518,18 +717,25 @@ func certificateFieldListObjectFromAPI(ctx context.Context, diags *diag.Diagnost
 	deviceMetadata, d := utils.ToOptionalList(ctx, cfl.DeviceMetadata, state, p.AtName("device_metadata"))
 	diags.Append(d...)
 
+	insecureIncludeRequested, d := utils.ToOptionalBool(ctx, cfl.InsecureIncludeRequested, state, p.AtName("insecure_include_requested"))
+	diags.Append(d...)
+
 	obj, d := basetypes.NewObjectValue(certificateFieldListAttributes, map[string]attr.Value{
-		"static":          static,
-		"device_metadata": deviceMetadata,
+		"static":                     static,
+		"device_metadata":            deviceMetadata,
+		"insecure_include_requested": insecureIncludeRequested,
 	})
 	diags.Append(d...)
 
 	return obj
 }
 
-func x509ObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, x509 v20250101.X509Fields, state utils.AttributeGetter) types.Object {
+func x509ObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, x509 v20260501.X509Fields, state utils.AttributeGetter) types.Object {
 	p := path.Root("certificate").AtName("x509")
 
+	extendedKeyUsage, d := utils.ToOptionalList(ctx, x509.ExtendedKeyUsage, state, p.AtName("extended_key_usage"))
+	diags.Append(d...)
+
 	obj, d := basetypes.NewObjectValue(x509Attributes, map[string]attr.Value{
 		"common_name":         certificateFieldObjectFromAPI(ctx, diags, x509.CommonName, state, p.AtName("common_name")),
 		"sans":                certificateFieldListObjectFromAPI(ctx, diags, x509.Sans, state, p.AtName("sans")),
@@ -540,13 +746,118 @@ func x509ObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, x509 v20250
 		"street_address":      certificateFieldListObjectFromAPI(ctx, diags, x509.StreetAddress, state, p.AtName("street_address")),
 		"postal_code":         certificateFieldListObjectFromAPI(ctx, diags, x509.PostalCode, state, p.AtName("postal_code")),
 		"country":             certificateFieldListObjectFromAPI(ctx, diags, x509.Country, state, p.AtName("country")),
+		"given_name":          certificateFieldObjectFromAPI(ctx, diags, x509.GivenName, state, p.AtName("given_name")),
+		"surname":             certificateFieldObjectFromAPI(ctx, diags, x509.Surname, state, p.AtName("surname")),
+		"serial_number":       certificateFieldObjectFromAPI(ctx, diags, x509.SerialNumber, state, p.AtName("serial_number")),
+		"typed_sans":          typedSANsObjectFromAPI(ctx, diags, x509.TypedSans, state, p.AtName("typed_sans")),
+		"extended_key_usage":  extendedKeyUsage,
+		"custom_extensions":   customExtensionsFromAPI(ctx, diags, x509.CustomExtensions, state, p.AtName("custom_extensions")),
 	})
 	diags.Append(d...)
 
 	return obj
 }
 
-func keyObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, key v20250101.CredentialKey, state utils.AttributeGetter) types.Object {
+func typedSANsObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, sans *v20260501.X509TypedSANs, state utils.AttributeGetter, p path.Path) types.Object {
+	if sans == nil {
+		return basetypes.NewObjectNull(typedSANsAttributes)
+	}
+
+	obj, d := basetypes.NewObjectValue(typedSANsAttributes, map[string]attr.Value{
+		"dns_names":            certificateFieldListObjectFromAPI(ctx, diags, sans.DnsNames, state, p.AtName("dns_names")),
+		"email_addresses":      certificateFieldListObjectFromAPI(ctx, diags, sans.EmailAddresses, state, p.AtName("email_addresses")),
+		"ip_addresses":         certificateFieldListObjectFromAPI(ctx, diags, sans.IpAddresses, state, p.AtName("ip_addresses")),
+		"uris":                 certificateFieldListObjectFromAPI(ctx, diags, sans.Uris, state, p.AtName("uris")),
+		"user_principal_names": certificateFieldListObjectFromAPI(ctx, diags, sans.UserPrincipalNames, state, p.AtName("user_principal_names")),
+	})
+	diags.Append(d...)
+
+	return obj
+}
+
+func customExtensionsFromAPI(ctx context.Context, diags *diag.Diagnostics, extensions *[]v20260501.X509CustomExtension, state utils.AttributeGetter, p path.Path) types.List {
+	elemType := types.ObjectType{AttrTypes: customExtensionAttributes}
+	if extensions == nil || len(*extensions) == 0 {
+		// Keep an empty list from state, like ToOptionalList.
+		fromState := types.ListNull(elemType)
+		diags.Append(state.GetAttribute(ctx, p, &fromState)...)
+		if !fromState.IsNull() && len(fromState.Elements()) == 0 {
+			return fromState
+		}
+		return types.ListNull(elemType)
+	}
+
+	var elems []attr.Value
+	for i, extension := range *extensions {
+		critical, d := utils.ToOptionalBool(ctx, extension.Critical, state, p.AtListIndex(i).AtName("critical"))
+		diags.Append(d...)
+
+		obj, d := basetypes.NewObjectValue(customExtensionAttributes, map[string]attr.Value{
+			"oid":      types.StringValue(extension.Oid),
+			"value":    types.StringValue(base64.StdEncoding.EncodeToString(extension.Value)),
+			"critical": critical,
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	return list
+}
+
+func namePolicyObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, policy *v20260501.X509NamePolicy, state utils.AttributeGetter) types.Object {
+	if policy == nil {
+		return basetypes.NewObjectNull(namePolicyAttributes)
+	}
+	p := path.Root("certificate").AtName("name_policy")
+
+	allowWildcardNames, d := utils.ToOptionalBool(ctx, policy.AllowWildcardNames, state, p.AtName("allow_wildcard_names"))
+	diags.Append(d...)
+
+	obj, d := basetypes.NewObjectValue(namePolicyAttributes, map[string]attr.Value{
+		"allow":                namesObjectFromAPI(ctx, diags, policy.Allow, state, p.AtName("allow")),
+		"deny":                 namesObjectFromAPI(ctx, diags, policy.Deny, state, p.AtName("deny")),
+		"allow_wildcard_names": allowWildcardNames,
+	})
+	diags.Append(d...)
+
+	return obj
+}
+
+func namesObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, names *v20260501.X509Names, state utils.AttributeGetter, p path.Path) types.Object {
+	if names == nil {
+		return basetypes.NewObjectNull(namesAttributes)
+	}
+
+	commonNames, d := utils.ToOptionalList(ctx, names.CommonNames, state, p.AtName("common_names"))
+	diags.Append(d...)
+
+	dns, d := utils.ToOptionalList(ctx, names.Dns, state, p.AtName("dns"))
+	diags.Append(d...)
+
+	emails, d := utils.ToOptionalList(ctx, names.Emails, state, p.AtName("emails"))
+	diags.Append(d...)
+
+	ips, d := utils.ToOptionalList(ctx, names.Ips, state, p.AtName("ips"))
+	diags.Append(d...)
+
+	uris, d := utils.ToOptionalList(ctx, names.Uris, state, p.AtName("uris"))
+	diags.Append(d...)
+
+	obj, d := basetypes.NewObjectValue(namesAttributes, map[string]attr.Value{
+		"common_names": commonNames,
+		"dns":          dns,
+		"emails":       emails,
+		"ips":          ips,
+		"uris":         uris,
+	})
+	diags.Append(d...)
+
+	return obj
+}
+
+func keyObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, key v20260501.CredentialKey, state utils.AttributeGetter) types.Object {
 	pubFile, ds := utils.ToOptionalString(ctx, key.PubFile, state, path.Root("key").AtName("pub_file"))
 	diags.Append(ds...)
 
@@ -556,16 +867,104 @@ func keyObjectFromAPI(ctx context.Context, diags *diag.Diagnostics, key v2025010
 	protection, ds := utils.ToOptionalString(ctx, key.Protection, state, path.Root("key").AtName("protection"))
 	diags.Append(ds...)
 
+	store, ds := utils.ToOptionalString(ctx, key.Store, state, path.Root("key").AtName("store"))
+	diags.Append(ds...)
+
+	compatibility, ds := utils.ToOptionalString(ctx, key.Compatibility, state, path.Root("key").AtName("compatibility"))
+	diags.Append(ds...)
+
 	out, ds := basetypes.NewObjectValue(keyAttributes, map[string]attr.Value{
-		"pub_file":   pubFile,
-		"type":       typ,
-		"protection": protection,
+		"pub_file":      pubFile,
+		"type":          typ,
+		"protection":    protection,
+		"store":         store,
+		"compatibility": compatibility,
 	})
 	diags.Append(ds...)
 
 	return out
 }
 
+// checkAPIVersion reports the attributes set in the plan that the 2025-01-01
+// API doesn't have when the provider is pinned to it. Unknown values are
+// checked again when they're known.
+func (m *CredentialModel) checkAPIVersion(ctx context.Context, diags *diag.Diagnostics, apiVersion string) {
+	if apiVersion != clientset.Version20250101 {
+		return
+	}
+
+	key := KeyModel{
+		Store:         types.StringNull(),
+		Compatibility: types.StringNull(),
+	}
+	if !m.Key.IsNull() && !m.Key.IsUnknown() {
+		diags.Append(m.Key.As(ctx, &key, basetypes.ObjectAsOptions{})...)
+	}
+
+	cert := CertificateModel{
+		X509:       types.ObjectNull(x509Attributes),
+		NamePolicy: types.ObjectNull(namePolicyAttributes),
+	}
+	if !m.Certificate.IsNull() && !m.Certificate.IsUnknown() {
+		diags.Append(m.Certificate.As(ctx, &cert, basetypes.ObjectAsOptions{})...)
+	}
+
+	x509 := X509Model{
+		GivenName:        types.ObjectNull(certificateFieldAttributes),
+		Surname:          types.ObjectNull(certificateFieldAttributes),
+		SerialNumber:     types.ObjectNull(certificateFieldAttributes),
+		TypedSANs:        types.ObjectNull(typedSANsAttributes),
+		ExtendedKeyUsage: types.ListNull(types.StringType),
+		CustomExtensions: types.ListNull(types.ObjectType{AttrTypes: customExtensionAttributes}),
+	}
+	if !cert.X509.IsNull() && !cert.X509.IsUnknown() {
+		diags.Append(cert.X509.As(ctx, &x509, basetypes.ObjectAsOptions{})...)
+	}
+
+	certificate := path.Root("certificate")
+	type attribute struct {
+		path  path.Path
+		value attr.Value
+	}
+	checked := []attribute{
+		{path.Root("management_mode"), m.ManagementMode},
+		{path.Root("key").AtName("store"), key.Store},
+		{path.Root("key").AtName("compatibility"), key.Compatibility},
+		{certificate.AtName("name_policy"), cert.NamePolicy},
+		{certificate.AtName("x509").AtName("given_name"), x509.GivenName},
+		{certificate.AtName("x509").AtName("surname"), x509.Surname},
+		{certificate.AtName("x509").AtName("serial_number"), x509.SerialNumber},
+		{certificate.AtName("x509").AtName("typed_sans"), x509.TypedSANs},
+		{certificate.AtName("x509").AtName("extended_key_usage"), x509.ExtendedKeyUsage},
+		{certificate.AtName("x509").AtName("custom_extensions"), x509.CustomExtensions},
+	}
+	if !cert.X509.IsNull() && !cert.X509.IsUnknown() {
+		for _, name := range certificateFieldLists {
+			list, ok := cert.X509.Attributes()[name].(types.Object)
+			if !ok || list.IsNull() || list.IsUnknown() {
+				continue
+			}
+			model := CertificateFieldListModel{}
+			diags.Append(list.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+			checked = append(checked, attribute{
+				certificate.AtName("x509").AtName(name).AtName("insecure_include_requested"),
+				model.InsecureIncludeRequested,
+			})
+		}
+	}
+
+	for _, newer := range checked {
+		if newer.value.IsNull() || newer.value.IsUnknown() {
+			continue
+		}
+		diags.AddAttributeError(
+			newer.path,
+			"Unsupported Smallstep API Version",
+			fmt.Sprintf("%s requires the provider's api_version to be %s.", newer.path, clientset.Version20260501),
+		)
+	}
+}
+
 func isAttested(keyType types.String) bool {
 	return keyType.ValueString() == "HARDWARE_ATTESTED"
 }
@@ -0,0 +1,56 @@
+package credential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+
+	utils.RoundTrip[v20260501.Credential]{
+		Version: "2026-05-01",
+		Schema:  "credential",
+		Prepare: func(t *testing.T, credential *v20260501.Credential) bool {
+			// The resource only manages X.509 certificates and requires the
+			// x509 fields.
+			credential.Certificate.Type = v20260501.CredentialCertificateTypeX509
+			fields, _ := credential.Certificate.Fields.AsX509Fields()
+			require.NoError(t, credential.Certificate.Fields.FromX509Fields(fields))
+			return true
+		},
+		Convert: func(t *testing.T, credential *v20260501.Credential) any {
+			var diags diag.Diagnostics
+			model := fromAPI(ctx, &diags, credential, state)
+			got := toAPI(ctx, &diags, &model, clientset.Version20260501)
+			require.False(t, diags.HasError(), diags)
+			return got
+		},
+	}.Run(t)
+}
+
+func TestCertificateToAPIDuration(t *testing.T) {
+	ctx := context.Background()
+	cert := CertificateModel{
+		AuthorityID: types.StringValue("c0ffee"),
+		Duration:    types.StringNull(),
+		X509:        types.ObjectNull(x509Attributes),
+	}
+
+	var diags diag.Diagnostics
+	assert.Equal(t, utils.Ref(""), cert.toAPI(ctx, &diags, clientset.Version20250101).Duration)
+	assert.Nil(t, cert.toAPI(ctx, &diags, clientset.Version20260501).Duration)
+
+	cert.Duration = types.StringValue("8h")
+	assert.Equal(t, utils.Ref("8h"), cert.toAPI(ctx, &diags, clientset.Version20260501).Duration)
+	require.False(t, diags.HasError(), diags)
+}
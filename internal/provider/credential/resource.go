@@ -4,31 +4,38 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithUpgradeState = (*Resource)(nil)
+var _ resource.ResourceWithModifyPlan = (*Resource)(nil)
 
 func NewResource() resource.Resource {
 	return &Resource{}
 }
 
 type Resource struct {
-	client *v20250101.Client
+	client     *v20260501.Client
+	apiVersion string
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	credential, props, err := utils.Describe("credential")
+	credential, props, err := utils.DescribeV20260501("credential")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Schema",
@@ -37,7 +44,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	cert, certProps, err := utils.Describe("credentialCertificate")
+	cert, certProps, err := utils.DescribeV20260501("credentialCertificate")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Certificate Schema",
@@ -46,7 +53,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	policy, policyProps, err := utils.Describe("policyMatchCriteria")
+	policy, policyProps, err := utils.DescribeV20260501("policyMatchCriteria")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Device Policy Schema",
@@ -55,7 +62,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	files, filesProps, err := utils.Describe("credentialFiles")
+	files, filesProps, err := utils.DescribeV20260501("credentialFiles")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Files Schema",
@@ -64,7 +71,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	x509, _, err := utils.Describe("x509Fields")
+	x509, x509Props, err := utils.DescribeV20260501("x509Fields")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI X509 Certificate Schema",
@@ -73,7 +80,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	_, certFieldProps, err := utils.Describe("certificateField")
+	_, certFieldProps, err := utils.DescribeV20260501("certificateField")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI X509 Certificate Schema",
@@ -82,7 +89,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	_, certFieldListProps, err := utils.Describe("certificateFieldList")
+	_, certFieldListProps, err := utils.DescribeV20260501("certificateFieldList")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI X509 Certificate Schema",
@@ -118,10 +125,65 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				ElementType:         types.StringType,
 				Optional:            true,
 			},
+			"insecure_include_requested": schema.BoolAttribute{
+				MarkdownDescription: certFieldListProps["insecureIncludeRequested"] + requires20260501,
+				Optional:            true,
+			},
 		},
 	}
 
-	key, keyProps, err := utils.Describe("credentialKey")
+	optionalName := name
+	optionalName.Required = false
+	optionalName.Optional = true
+
+	_, typedSANsProps, err := utils.DescribeV20260501("x509TypedSANs")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Smallstep OpenAPI X509 Typed SANs Schema",
+			err.Error(),
+		)
+		return
+	}
+
+	_, extensionProps, err := utils.DescribeV20260501("x509CustomExtension")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Smallstep OpenAPI X509 Custom Extension Schema",
+			err.Error(),
+		)
+		return
+	}
+
+	namePolicy, namePolicyProps, err := utils.DescribeV20260501("x509NamePolicy")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Smallstep OpenAPI X509 Name Policy Schema",
+			err.Error(),
+		)
+		return
+	}
+
+	names := func(description string) schema.SingleNestedAttribute {
+		list := func() schema.ListAttribute {
+			return schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			}
+		}
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"common_names": list(),
+				"dns":          list(),
+				"emails":       list(),
+				"ips":          list(),
+				"uris":         list(),
+			},
+		}
+	}
+
+	key, keyProps, err := utils.DescribeV20260501("credentialKey")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Credential Key Info Schema",
@@ -132,6 +194,10 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: credential,
+		// Version 1 adds management_mode and the key's store and
+		// compatibility from the 2026-05-01 API. Version 2 adds the
+		// certificate's name_policy and the x509 fields of that API.
+		Version: 2,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -145,6 +211,10 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				MarkdownDescription: props["slug"],
 				Required:            true,
 			},
+			"management_mode": schema.StringAttribute{
+				MarkdownDescription: props["managementMode"] + requires20260501,
+				Optional:            true,
+			},
 			"certificate": schema.SingleNestedAttribute{
 				MarkdownDescription: cert,
 				Required:            true,
@@ -162,12 +232,66 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							"province":            nameList,
 							"street_address":      nameList,
 							"postal_code":         nameList,
+							"given_name":          withDescription(optionalName, x509Props["givenName"]+requires20260501),
+							"surname":             withDescription(optionalName, x509Props["surname"]+requires20260501),
+							"serial_number":       withDescription(optionalName, x509Props["serialNumber"]+requires20260501),
+							"typed_sans": schema.SingleNestedAttribute{
+								MarkdownDescription: x509Props["typedSans"] + requires20260501,
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"dns_names":            withDescription(nameList, typedSANsProps["dnsNames"]),
+									"email_addresses":      withDescription(nameList, typedSANsProps["emailAddresses"]),
+									"ip_addresses":         withDescription(nameList, typedSANsProps["ipAddresses"]),
+									"uris":                 withDescription(nameList, typedSANsProps["uris"]),
+									"user_principal_names": withDescription(nameList, typedSANsProps["userPrincipalNames"]),
+								},
+							},
+							"extended_key_usage": schema.ListAttribute{
+								MarkdownDescription: x509Props["extendedKeyUsage"] + requires20260501,
+								ElementType:         types.StringType,
+								Optional:            true,
+							},
+							"custom_extensions": schema.ListNestedAttribute{
+								MarkdownDescription: x509Props["customExtensions"] + requires20260501,
+								Optional:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"oid": schema.StringAttribute{
+											MarkdownDescription: extensionProps["oid"],
+											Required:            true,
+										},
+										"value": schema.StringAttribute{
+											MarkdownDescription: extensionProps["value"],
+											Required:            true,
+										},
+										"critical": schema.BoolAttribute{
+											MarkdownDescription: extensionProps["critical"],
+											Optional:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+					"name_policy": schema.SingleNestedAttribute{
+						MarkdownDescription: namePolicy + requires20260501,
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"allow": names(namePolicyProps["allow"]),
+							"deny":  names(namePolicyProps["deny"]),
+							"allow_wildcard_names": schema.BoolAttribute{
+								MarkdownDescription: namePolicyProps["allowWildcardNames"],
+								Optional:            true,
+							},
 						},
 					},
 					"duration": schema.StringAttribute{
 						MarkdownDescription: certProps["duration"],
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+						},
 						PlanModifiers: []planmodifier.String{
 							// If unset the duration will default to 24h.
 							stringplanmodifier.UseStateForUnknown(),
@@ -211,6 +335,14 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							),
 						},
 					},
+					"store": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: keyProps["store"] + requires20260501,
+					},
+					"compatibility": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: keyProps["compatibility"] + requires20260501,
+					},
 				},
 			},
 			"policy": schema.SingleNestedAttribute{
@@ -282,6 +414,165 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 }
 
+// requires20260501 is appended to the descriptions of attributes only the
+// 2026-05-01 API has.
+const requires20260501 = " Requires the provider's `api_version` to be `2026-05-01`."
+
+func withDescription(a schema.SingleNestedAttribute, description string) schema.SingleNestedAttribute {
+	a.MarkdownDescription = description
+	return a
+}
+
+// credentialModelV0 is the state of schema version 0.
+type credentialModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Slug        types.String `tfsdk:"slug"`
+	Certificate types.Object `tfsdk:"certificate"`
+	Key         types.Object `tfsdk:"key"`
+	Policy      types.Object `tfsdk:"policy"`
+	Files       types.Object `tfsdk:"files"`
+}
+
+// schemaV1 returns schema version 1, which is the current schema without the
+// attributes version 2 added.
+func schemaV1(current schema.Schema) schema.Schema {
+	attrs := maps.Clone(current.Attributes)
+
+	cert := attrs["certificate"].(schema.SingleNestedAttribute)
+	cert.Attributes = maps.Clone(cert.Attributes)
+	delete(cert.Attributes, "name_policy")
+
+	x509 := cert.Attributes["x509"].(schema.SingleNestedAttribute)
+	x509.Attributes = maps.Clone(x509.Attributes)
+	for _, name := range []string{"given_name", "surname", "serial_number", "typed_sans", "extended_key_usage", "custom_extensions"} {
+		delete(x509.Attributes, name)
+	}
+	for _, name := range certificateFieldLists {
+		list := x509.Attributes[name].(schema.SingleNestedAttribute)
+		list.Attributes = maps.Clone(list.Attributes)
+		delete(list.Attributes, "insecure_include_requested")
+		x509.Attributes[name] = list
+	}
+	cert.Attributes["x509"] = x509
+	attrs["certificate"] = cert
+
+	current.Attributes = attrs
+	current.Version = 1
+	return current
+}
+
+// schemaV0 returns schema version 0, which is schema version 1 without
+// management_mode and the key's store and compatibility.
+func schemaV0(current schema.Schema) schema.Schema {
+	current = schemaV1(current)
+	attrs := maps.Clone(current.Attributes)
+	delete(attrs, "management_mode")
+
+	key := attrs["key"].(schema.SingleNestedAttribute)
+	key.Attributes = maps.Clone(key.Attributes)
+	delete(key.Attributes, "store")
+	delete(key.Attributes, "compatibility")
+	attrs["key"] = key
+
+	current.Attributes = attrs
+	current.Version = 0
+	return current
+}
+
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	current := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, current)
+	priorV0 := schemaV0(current.Schema)
+	priorV1 := schemaV1(current.Schema)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				state := &credentialModelV0{}
+				resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				key := types.ObjectNull(keyAttributes)
+				if !state.Key.IsNull() {
+					attrs := state.Key.Attributes()
+					attrs["store"] = types.StringNull()
+					attrs["compatibility"] = types.StringNull()
+					var diags diag.Diagnostics
+					key, diags = types.ObjectValue(keyAttributes, attrs)
+					resp.Diagnostics.Append(diags...)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, CredentialModel{
+					ID:             state.ID,
+					Slug:           state.Slug,
+					ManagementMode: types.StringNull(),
+					Certificate:    upgradeCertificate(&resp.Diagnostics, state.Certificate),
+					Key:            key,
+					Policy:         state.Policy,
+					Files:          state.Files,
+				})...)
+			},
+		},
+		1: {
+			PriorSchema: &priorV1,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				state := &CredentialModel{}
+				resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state.Certificate = upgradeCertificate(&resp.Diagnostics, state.Certificate)
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// upgradeCertificate adds the attributes schema version 2 added to the
+// certificate of an older state.
+func upgradeCertificate(diags *diag.Diagnostics, cert types.Object) types.Object {
+	if cert.IsNull() {
+		return types.ObjectNull(certificateAttributes)
+	}
+
+	attrs := cert.Attributes()
+	attrs["name_policy"] = types.ObjectNull(namePolicyAttributes)
+
+	x509 := types.ObjectNull(x509Attributes)
+	if prior, ok := attrs["x509"].(types.Object); ok && !prior.IsNull() {
+		x509Attrs := prior.Attributes()
+		x509Attrs["given_name"] = types.ObjectNull(certificateFieldAttributes)
+		x509Attrs["surname"] = types.ObjectNull(certificateFieldAttributes)
+		x509Attrs["serial_number"] = types.ObjectNull(certificateFieldAttributes)
+		x509Attrs["typed_sans"] = types.ObjectNull(typedSANsAttributes)
+		x509Attrs["extended_key_usage"] = types.ListNull(types.StringType)
+		x509Attrs["custom_extensions"] = types.ListNull(types.ObjectType{AttrTypes: customExtensionAttributes})
+		for _, name := range certificateFieldLists {
+			list := types.ObjectNull(certificateFieldListAttributes)
+			if prior, ok := x509Attrs[name].(types.Object); ok && !prior.IsNull() {
+				listAttrs := prior.Attributes()
+				listAttrs["insecure_include_requested"] = types.BoolNull()
+				var d diag.Diagnostics
+				list, d = types.ObjectValue(certificateFieldListAttributes, listAttrs)
+				diags.Append(d...)
+			}
+			x509Attrs[name] = list
+		}
+		var d diag.Diagnostics
+		x509, d = types.ObjectValue(x509Attributes, x509Attrs)
+		diags.Append(d...)
+	}
+	attrs["x509"] = x509
+
+	out, d := types.ObjectValue(certificateAttributes, attrs)
+	diags.Append(d...)
+	return out
+}
+
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = name
 }
@@ -303,10 +594,31 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
+	r.apiVersion = clients.APIVersion
+}
+
+// ModifyPlan fails the plan when it sets attributes the API version the
+// provider is pinned to doesn't have, instead of failing partway through the
+// apply.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Delete
+		return
+	}
+
+	plan := &CredentialModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.checkAPIVersion(ctx, &resp.Diagnostics, r.apiVersion)
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, name, "read")
+
 	state := &CredentialModel{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -323,7 +635,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	httpResp, err := r.client.GetCredential(ctx, credentialID, &v20250101.GetCredentialParams{})
+	httpResp, err := r.client.GetCredential(ctx, credentialID, &v20260501.GetCredentialParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -346,7 +658,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	credential := &v20250101.Credential{}
+	credential := &v20260501.Credential{}
 	if err := json.NewDecoder(httpResp.Body).Decode(credential); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -364,18 +676,20 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "create")
+
 	plan := &CredentialModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	reqBody := toAPI(ctx, &resp.Diagnostics, plan)
+	reqBody := toAPI(ctx, &resp.Diagnostics, plan, a.apiVersion)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	httpResp, err := a.client.PostCredentials(ctx, &v20250101.PostCredentialsParams{}, reqBody)
+	httpResp, err := a.client.PostCredentials(ctx, &v20260501.PostCredentialsParams{}, reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -394,7 +708,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	credential := &v20250101.Credential{}
+	credential := &v20260501.Credential{}
 	if err := json.NewDecoder(httpResp.Body).Decode(credential); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -412,6 +726,8 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "update")
+
 	plan := &CredentialModel{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
@@ -420,13 +736,13 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 	credentialID := plan.ID.ValueString()
 
-	reqBody := toAPI(ctx, &resp.Diagnostics, plan)
+	reqBody := toAPI(ctx, &resp.Diagnostics, plan, r.apiVersion)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	httpResp, err := r.client.PutCredential(ctx, credentialID, &v20250101.PutCredentialParams{}, reqBody)
+	httpResp, err := r.client.PutCredential(ctx, credentialID, &v20260501.PutCredentialParams{}, reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -445,7 +761,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	credential := &v20250101.Credential{}
+	credential := &v20260501.Credential{}
 	if err := json.NewDecoder(httpResp.Body).Decode(credential); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -461,6 +777,8 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, name, "delete")
+
 	state := &CredentialModel{}
 	diags := req.State.Get(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -477,7 +795,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.DeleteCredential(ctx, credentialID, &v20250101.DeleteCredentialParams{})
+	httpResp, err := r.client.DeleteCredential(ctx, credentialID, &v20260501.DeleteCredentialParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -1,12 +1,25 @@
 package credential
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccCredentialResource(t *testing.T) {
@@ -258,3 +271,226 @@ resource "smallstep_credential" "test" {
 		},
 	})
 }
+
+func TestAccCredentialResourceAPIVersion(t *testing.T) {
+	authority := utils.NewAuthority(t)
+	slug := "tfprovider-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	config := fmt.Sprintf(`
+resource "smallstep_credential" "test" {
+	slug = %q
+	management_mode = "agent"
+	certificate = {
+		authority_id = %q
+		x509 = {
+			common_name = {
+				device_metadata = "smallstep:identity"
+			}
+		}
+	}
+	key = {
+		type = "ECDSA_P256"
+		protection = "HARDWARE"
+		store = "MACHINE"
+		compatibility = "LEGACY"
+	}
+}
+`, slug, authority.Id)
+
+	step := helper.TestStep{
+		Config: config,
+		Check: helper.ComposeAggregateTestCheckFunc(
+			helper.TestCheckResourceAttr("smallstep_credential.test", "management_mode", "agent"),
+			helper.TestCheckResourceAttr("smallstep_credential.test", "key.store", "MACHINE"),
+			helper.TestCheckResourceAttr("smallstep_credential.test", "key.compatibility", "LEGACY"),
+		),
+	}
+	if utils.APIVersionFromEnv() == clientset.Version20250101 {
+		step = helper.TestStep{
+			Config:      config,
+			ExpectError: regexp.MustCompile(`Unsupported Smallstep API Version`),
+		}
+	}
+
+	helper.Test(t, helper.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps:                    []helper.TestStep{step},
+	})
+}
+
+func TestUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := NewResource().(*Resource)
+	upgraders := r.UpgradeState(ctx)
+	require.Contains(t, upgraders, int64(0))
+	upgrader := upgraders[0]
+
+	key, diags := types.ObjectValue(map[string]attr.Type{
+		"type":       types.StringType,
+		"protection": types.StringType,
+		"pub_file":   types.StringType,
+	}, map[string]attr.Value{
+		"type":       types.StringValue("ECDSA_P256"),
+		"protection": types.StringValue("HARDWARE"),
+		"pub_file":   types.StringNull(),
+	})
+	require.False(t, diags.HasError(), diags)
+
+	prior := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	require.False(t, prior.Set(ctx, credentialModelV0{
+		ID:          types.StringValue("c0ffee"),
+		Slug:        types.StringValue("laptop"),
+		Certificate: types.ObjectNull(upgrader.PriorSchema.Attributes["certificate"].GetType().(types.ObjectType).AttrTypes),
+		Key:         key,
+		Policy:      types.ObjectNull(policyAttributes),
+		Files:       types.ObjectNull(filesAttributes),
+	}).HasError())
+
+	resp := &resource.UpgradeStateResponse{State: utils.NullState(t, r)}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	got := CredentialModel{}
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "laptop", got.Slug.ValueString())
+	assert.True(t, got.ManagementMode.IsNull())
+
+	gotKey := KeyModel{}
+	require.False(t, got.Key.As(ctx, &gotKey, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, KeyModel{
+		Type:          types.StringValue("ECDSA_P256"),
+		Protection:    types.StringValue("HARDWARE"),
+		PubFile:       types.StringNull(),
+		Store:         types.StringNull(),
+		Compatibility: types.StringNull(),
+	}, gotKey)
+}
+
+func TestUpgradeStateV1(t *testing.T) {
+	ctx := context.Background()
+	r := NewResource().(*Resource)
+	upgraders := r.UpgradeState(ctx)
+	require.Contains(t, upgraders, int64(1))
+	upgrader := upgraders[1]
+
+	prior := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	for p, v := range map[string]string{
+		"id":              "c0ffee",
+		"slug":            "laptop",
+		"management_mode": "agent",
+	} {
+		require.False(t, prior.SetAttribute(ctx, path.Root(p), v).HasError())
+	}
+	commonName := path.Root("certificate").AtName("x509").AtName("common_name").AtName("device_metadata")
+	require.False(t, prior.SetAttribute(ctx, commonName, "smallstep:identity").HasError())
+	require.False(t, prior.SetAttribute(ctx, path.Root("certificate").AtName("duration"), "24h").HasError())
+
+	resp := &resource.UpgradeStateResponse{State: utils.NullState(t, r)}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	got := CredentialModel{}
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "laptop", got.Slug.ValueString())
+	assert.Equal(t, "agent", got.ManagementMode.ValueString())
+
+	cert := CertificateModel{}
+	require.False(t, got.Certificate.As(ctx, &cert, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "24h", cert.Duration.ValueString())
+	assert.True(t, cert.NamePolicy.IsNull())
+
+	x509 := X509Model{}
+	require.False(t, cert.X509.As(ctx, &x509, basetypes.ObjectAsOptions{}).HasError())
+	assert.True(t, x509.GivenName.IsNull())
+	assert.True(t, x509.TypedSANs.IsNull())
+	assert.True(t, x509.ExtendedKeyUsage.IsNull())
+	assert.True(t, x509.CustomExtensions.IsNull())
+
+	field := CertificateFieldModel{}
+	require.False(t, x509.CommonName.As(ctx, &field, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "smallstep:identity", field.DeviceMetadata.ValueString())
+}
+
+func TestModifyPlanAPIVersion(t *testing.T) {
+	ctx := context.Background()
+
+	key := func(store types.String) types.Object {
+		key, diags := types.ObjectValue(keyAttributes, map[string]attr.Value{
+			"type":          types.StringValue("ECDSA_P256"),
+			"protection":    types.StringValue("HARDWARE"),
+			"pub_file":      types.StringNull(),
+			"store":         store,
+			"compatibility": types.StringNull(),
+		})
+		require.False(t, diags.HasError(), diags)
+		return key
+	}
+
+	tests := []struct {
+		name           string
+		apiVersion     string
+		managementMode types.String
+		key            types.Object
+		wantErrs       int
+	}{
+		{"unset", clientset.Version20250101, types.StringNull(), key(types.StringNull()), 0},
+		{"unknown", clientset.Version20250101, types.StringUnknown(), key(types.StringUnknown()), 0},
+		{"unknown key", clientset.Version20250101, types.StringNull(), types.ObjectUnknown(keyAttributes), 0},
+		{"set", clientset.Version20250101, types.StringValue("agent"), key(types.StringValue("MACHINE")), 2},
+		{"newer version", clientset.Version20260501, types.StringValue("agent"), key(types.StringValue("MACHINE")), 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Resource{apiVersion: tc.apiVersion}
+			state := utils.NullState(t, r)
+			require.False(t, state.Set(ctx, CredentialModel{
+				ID:             types.StringUnknown(),
+				Slug:           types.StringValue("laptop"),
+				ManagementMode: tc.managementMode,
+				Certificate:    types.ObjectNull(certificateAttributes),
+				Key:            tc.key,
+				Policy:         types.ObjectNull(policyAttributes),
+				Files:          types.ObjectNull(filesAttributes),
+			}).HasError())
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+			assert.Len(t, resp.Diagnostics.Errors(), tc.wantErrs, resp.Diagnostics)
+			for _, err := range resp.Diagnostics.Errors() {
+				assert.Equal(t, "Unsupported Smallstep API Version", err.Summary())
+			}
+		})
+	}
+}
+
+func TestModifyPlanAPIVersion_certificate(t *testing.T) {
+	ctx := context.Background()
+	r := &Resource{apiVersion: clientset.Version20250101}
+	state := utils.NullState(t, r)
+
+	x509 := path.Root("certificate").AtName("x509")
+	require.False(t, state.SetAttribute(ctx, x509.AtName("common_name").AtName("static"), "laptop").HasError())
+	require.False(t, state.SetAttribute(ctx, x509.AtName("sans").AtName("insecure_include_requested"), true).HasError())
+	require.False(t, state.SetAttribute(ctx, x509.AtName("extended_key_usage"), []string{"clientAuth"}).HasError())
+	require.False(t, state.SetAttribute(ctx, path.Root("certificate").AtName("name_policy").AtName("allow_wildcard_names"), false).HasError())
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+	var paths []string
+	for _, err := range resp.Diagnostics.Errors() {
+		paths = append(paths, err.(diag.DiagnosticWithPath).Path().String())
+	}
+	assert.ElementsMatch(t, []string{
+		"certificate.name_policy",
+		"certificate.x509.extended_key_usage",
+		"certificate.x509.sans.insecure_include_requested",
+	}, paths)
+}
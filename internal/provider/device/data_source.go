@@ -9,8 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -21,7 +21,7 @@ func NewDataSource() datasource.DataSource {
 }
 
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (a *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,11 +45,11 @@ func (ds *DataSource) Configure(ctx context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	ds.client = clients.V20250101
+	ds.client = clients.Pinned
 }
 
 func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	device, props, err := utils.Describe("device")
+	device, props, err := utils.DescribeV20260501("device")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Device Schema",
@@ -58,7 +58,7 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 		return
 	}
 
-	deviceUser, userProps, err := utils.Describe("deviceUser")
+	deviceUser, userProps, err := utils.DescribeV20260501("deviceUser")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Device User Schema",
@@ -147,11 +147,17 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 				MarkdownDescription: props["hostID"],
 				Computed:            true,
 			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: props["shared"],
+				Computed:            true,
+			},
 		},
 	}
 }
 
 func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+typeName, "read")
+
 	var config Model
 
 	// Read Terraform configuration data into the model
@@ -169,7 +175,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	httpResp, err := ds.client.GetDevice(ctx, deviceID, &v20250101.GetDeviceParams{})
+	httpResp, err := ds.client.GetDevice(ctx, deviceID, &v20260501.GetDeviceParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -192,7 +198,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	device := &v20250101.Device{}
+	device := &v20260501.Device{}
 	if err := json.NewDecoder(httpResp.Body).Decode(device); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
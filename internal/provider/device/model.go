@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -33,6 +33,7 @@ type Model struct {
 	Connected           types.Bool   `tfsdk:"connected"`
 	HighAssurance       types.Bool   `tfsdk:"high_assurance"`
 	HostID              types.String `tfsdk:"host_id"`
+	Shared              types.Bool   `tfsdk:"shared"`
 }
 
 type UserModel struct {
@@ -40,14 +41,14 @@ type UserModel struct {
 	Email       types.String `tfsdk:"email"`
 }
 
-func (user *UserModel) AsAPI(ctx context.Context) (*v20250101.DeviceUser, diag.Diagnostics) {
+func (user *UserModel) AsAPI(ctx context.Context) (*v20260501.DeviceUser, diag.Diagnostics) {
 	d := diag.Diagnostics{}
 
 	if user == nil {
 		return nil, d
 	}
 
-	return &v20250101.DeviceUser{
+	return &v20260501.DeviceUser{
 		DisplayName: user.DisplayName.ValueStringPointer(),
 		Email:       user.Email.ValueString(),
 	}, d
@@ -58,7 +59,7 @@ var userAttrTypes = map[string]attr.Type{
 	"email":        types.StringType,
 }
 
-func fromAPI(ctx context.Context, device *v20250101.Device, state utils.AttributeGetter) (*Model, diag.Diagnostics) {
+func fromAPI(ctx context.Context, device *v20260501.Device, state utils.AttributeGetter) (*Model, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	model := &Model{
@@ -89,6 +90,10 @@ func fromAPI(ctx context.Context, device *v20250101.Device, state utils.Attribut
 	diags.Append(d...)
 	model.Ownership = ownership
 
+	shared, d := utils.ToOptionalBool(ctx, device.Shared, state, path.Root("shared"))
+	diags.Append(d...)
+	model.Shared = shared
+
 	// user
 	if device.User != nil {
 		userDisplayName, d := utils.ToOptionalString(ctx, device.User.DisplayName, state, path.Root("user").AtName("display_name"))
@@ -153,11 +158,11 @@ func fromAPI(ctx context.Context, device *v20250101.Device, state utils.Attribut
 	return model, diags
 }
 
-func toAPI(ctx context.Context, m *Model) (*v20250101.DeviceRequest, diag.Diagnostics) {
+func toAPI(ctx context.Context, m *Model) (*v20260501.DeviceRequest, diag.Diagnostics) {
 	// user
 	diags := diag.Diagnostics{}
 
-	d := &v20250101.DeviceRequest{
+	d := &v20260501.DeviceRequest{
 		PermanentIdentifier: m.PermanentIdentifier.ValueString(),
 	}
 
@@ -171,10 +176,10 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.DeviceRequest, diag.Diagno
 		d.Serial = m.Serial.ValueStringPointer()
 	}
 	if !m.OS.IsNull() && !m.OS.IsUnknown() {
-		d.Os = utils.Ref(v20250101.DeviceOS(m.OS.ValueString()))
+		d.Os = utils.Ref(v20260501.DeviceOS(m.OS.ValueString()))
 	}
 	if !m.Ownership.IsNull() && !m.Ownership.IsUnknown() {
-		d.Ownership = utils.Ref(v20250101.DeviceOwnership(m.Ownership.ValueString()))
+		d.Ownership = utils.Ref(v20260501.DeviceOwnership(m.Ownership.ValueString()))
 	}
 	if !m.Metadata.IsNull() && !m.Metadata.IsUnknown() {
 		meta := map[string]types.String{}
@@ -182,7 +187,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.DeviceRequest, diag.Diagno
 		diags.Append(diag...)
 
 		if len(meta) > 0 {
-			metadata := v20250101.DeviceMetadata{}
+			metadata := v20260501.DeviceMetadata{}
 			for k, v := range meta {
 				metadata[k] = v.ValueString()
 			}
@@ -201,11 +206,11 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.DeviceRequest, diag.Diagno
 			UnhandledUnknownAsEmpty: true,
 		})
 		diags.Append(diag...)
-		d.User = &v20250101.DeviceUser{
+		d.User = &v20260501.DeviceUser{
 			DisplayName: user.DisplayName.ValueStringPointer(),
 			Email:       user.Email.ValueString(),
 		}
 	}
 
-	return d, nil
+	return d, diags
 }
@@ -0,0 +1,37 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+
+	utils.RoundTrip[v20260501.Device]{
+		Version: "2026-05-01",
+		Schema:  "device",
+		Convert: func(t *testing.T, device *v20260501.Device) any {
+			model, diags := fromAPI(ctx, device, state)
+			require.False(t, diags.HasError(), diags)
+			got, diags := toAPI(ctx, model)
+			require.False(t, diags.HasError(), diags)
+			return got
+		},
+		Want: func(t *testing.T, device *v20260501.Device) any {
+			// A device request has the properties of a device that are not
+			// read-only.
+			var want v20260501.DeviceRequest
+			b, err := json.Marshal(device)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &want))
+			return want
+		},
+	}.Run(t)
+}
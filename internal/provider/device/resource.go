@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,19 +16,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithUpgradeState = (*Resource)(nil)
+var _ resource.ResourceWithModifyPlan = (*Resource)(nil)
 
 func NewResource() resource.Resource {
 	return &Resource{}
 }
 
 type Resource struct {
-	client *v20250101.Client
+	client     *v20260501.Client
+	apiVersion string
 }
 
 func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -56,6 +61,16 @@ func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReques
 		resp.Plan.SetAttribute(ctx, p, config)
 	}
 
+	shared := types.Bool{}
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shared"), &shared)...)
+	if !shared.IsNull() && !shared.IsUnknown() && r.apiVersion == clientset.Version20250101 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("shared"),
+			"Unsupported Smallstep API Version",
+			fmt.Sprintf("shared requires the provider's api_version to be %s.", clientset.Version20260501),
+		)
+	}
+
 	tags := types.Set{}
 	diags := req.Config.GetAttribute(ctx, path.Root("tags"), &tags)
 	resp.Diagnostics.Append(diags...)
@@ -74,7 +89,7 @@ func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReques
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	device, props, err := utils.Describe("device")
+	device, props, err := utils.DescribeV20260501("device")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Device Schema",
@@ -83,7 +98,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	deviceUser, userProps, err := utils.Describe("deviceUser")
+	deviceUser, userProps, err := utils.DescribeV20260501("deviceUser")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Device User Schema",
@@ -94,6 +109,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: device,
+		// Version 1 adds shared from the 2026-05-01 API.
+		Version: 1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -187,6 +204,72 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				MarkdownDescription: props["hostID"],
 				Computed:            true,
 			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: props["shared"] + " Requires the provider's `api_version` to be `2026-05-01`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// modelV0 is the state of schema version 0.
+type modelV0 struct {
+	ID                  types.String `tfsdk:"id"`
+	PermanentIdentifier types.String `tfsdk:"permanent_identifier"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	DisplayID           types.String `tfsdk:"display_id"`
+	Serial              types.String `tfsdk:"serial"`
+	OS                  types.String `tfsdk:"os"`
+	Ownership           types.String `tfsdk:"ownership"`
+	User                types.Object `tfsdk:"user"`
+	Tags                types.Set    `tfsdk:"tags"`
+	Metadata            types.Map    `tfsdk:"metadata"`
+	ApprovedAt          types.String `tfsdk:"approved_at"`
+	EnrolledAt          types.String `tfsdk:"enrolled_at"`
+	LastSeen            types.String `tfsdk:"last_seen"`
+	Connected           types.Bool   `tfsdk:"connected"`
+	HighAssurance       types.Bool   `tfsdk:"high_assurance"`
+	HostID              types.String `tfsdk:"host_id"`
+}
+
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	current := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, current)
+	prior := current.Schema
+	prior.Attributes = maps.Clone(prior.Attributes)
+	delete(prior.Attributes, "shared")
+	prior.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &prior,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				state := &modelV0{}
+				resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, Model{
+					ID:                  state.ID,
+					PermanentIdentifier: state.PermanentIdentifier,
+					DisplayName:         state.DisplayName,
+					DisplayID:           state.DisplayID,
+					Serial:              state.Serial,
+					OS:                  state.OS,
+					Ownership:           state.Ownership,
+					User:                state.User,
+					Tags:                state.Tags,
+					Metadata:            state.Metadata,
+					ApprovedAt:          state.ApprovedAt,
+					EnrolledAt:          state.EnrolledAt,
+					LastSeen:            state.LastSeen,
+					Connected:           state.Connected,
+					HighAssurance:       state.HighAssurance,
+					HostID:              state.HostID,
+					Shared:              types.BoolNull(),
+				})...)
+			},
 		},
 	}
 }
@@ -212,10 +295,13 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
+	r.apiVersion = clients.APIVersion
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "read")
+
 	state := &Model{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -232,7 +318,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	httpResp, err := r.client.GetDevice(ctx, deviceID, &v20250101.GetDeviceParams{})
+	httpResp, err := r.client.GetDevice(ctx, deviceID, &v20260501.GetDeviceParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -255,7 +341,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	device := &v20250101.Device{}
+	device := &v20260501.Device{}
 	if err := json.NewDecoder(httpResp.Body).Decode(device); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -274,6 +360,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "create")
+
 	plan := &Model{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -286,7 +374,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	httpResp, err := a.client.PostDevices(ctx, &v20250101.PostDevicesParams{}, *reqBody)
+	httpResp, err := a.client.PostDevices(ctx, &v20260501.PostDevicesParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -305,7 +393,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	device := &v20250101.Device{}
+	device := &v20260501.Device{}
 	if err := json.NewDecoder(httpResp.Body).Decode(device); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -314,6 +402,21 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
+	// A new device can't be created shared, so it's set with a patch.
+	if plan.Shared.ValueBool() {
+		patched := a.patch(ctx, &resp.Diagnostics, device.Id, v20260501.DevicePatch{
+			Shared: plan.Shared.ValueBoolPointer(),
+		})
+		if patched == nil {
+			// Save the created device so it's tainted instead of orphaned.
+			model, diags := fromAPI(ctx, device, req.Plan)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+			return
+		}
+		device = patched
+	}
+
 	model, diags := fromAPI(ctx, device, req.Plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -325,6 +428,8 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "update")
+
 	plan := &Model{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
@@ -340,14 +445,14 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	patch := v20250101.DevicePatch{
-		DisplayId:   &v20250101.DevicePatch_DisplayId{},
-		DisplayName: &v20250101.DevicePatch_DisplayName{},
-		Metadata:    &v20250101.DevicePatch_Metadata{},
-		Os:          &v20250101.DevicePatch_Os{},
-		Ownership:   &v20250101.DevicePatch_Ownership{},
-		Serial:      &v20250101.DevicePatch_Serial{},
-		Tags:        &v20250101.DevicePatch_Tags{},
+	patch := v20260501.DevicePatch{
+		DisplayId:   &v20260501.DevicePatch_DisplayId{},
+		DisplayName: &v20260501.DevicePatch_DisplayName{},
+		Metadata:    &v20260501.DevicePatch_Metadata{},
+		Os:          &v20260501.DevicePatch_Os{},
+		Ownership:   &v20260501.DevicePatch_Ownership{},
+		Serial:      &v20260501.DevicePatch_Serial{},
+		Tags:        &v20260501.DevicePatch_Tags{},
 	}
 
 	if resource.DisplayId == nil {
@@ -438,49 +543,70 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	// Only include the user in the patch when an email is present; when it's
 	// absent we leave the device's existing user untouched.
 	if resource.User != nil && resource.User.Email != "" {
-		patch.User = &v20250101.DeviceUserPatch{}
+		patch.User = &v20260501.DeviceUserPatch{}
 		err := patch.User.Email.FromDeviceUserPatchEmail1(resource.User.Email)
 		if err != nil {
 			diags.AddError("prepare device patch: set user email", err.Error())
 		}
 	}
 
-	httpResp, err := r.client.PatchDevice(ctx, deviceID, &v20250101.PatchDeviceParams{}, patch)
+	// The 2025-01-01 API doesn't have shared.
+	if r.apiVersion != clientset.Version20250101 {
+		patch.Shared = utils.Ref(plan.Shared.ValueBool())
+	}
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device := r.patch(ctx, &resp.Diagnostics, deviceID, patch)
+	if device == nil {
+		return
+	}
+
+	model, diags := fromAPI(ctx, device, req.Plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// patch updates a device and returns it, or nil if the update failed.
+func (r *Resource) patch(ctx context.Context, diags *diag.Diagnostics, deviceID string, patch v20260501.DevicePatch) *v20260501.Device {
+	httpResp, err := r.client.PatchDevice(ctx, deviceID, &v20260501.PatchDeviceParams{}, patch)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Smallstep API Client Error",
 			err.Error(),
 		)
-		return
+		return nil
 	}
 	defer httpResp.Body.Close()
 
 	if httpResp.StatusCode != http.StatusOK {
 		reqID := httpResp.Header.Get("X-Request-Id")
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Smallstep API Response Error",
 			fmt.Sprintf("Request %q received status %d updating device: %s", reqID, httpResp.StatusCode, utils.APIErrorMsg(httpResp.Body)),
 		)
-		return
+		return nil
 	}
 
-	device := &v20250101.Device{}
+	device := &v20260501.Device{}
 	if err := json.NewDecoder(httpResp.Body).Decode(device); err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Smallstep API Client Error",
 			fmt.Sprintf("Failed to parse device update response: %v", err),
 		)
-		return
+		return nil
 	}
-
-	model, diags := fromAPI(ctx, device, req.Plan)
-	resp.Diagnostics.Append(diags...)
-
-	diags = resp.State.Set(ctx, model)
-	resp.Diagnostics.Append(diags...)
+	return device
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "delete")
+
 	state := &Model{}
 	diags := req.State.Get(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -497,7 +623,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.DeleteDevice(ctx, deviceID, &v20250101.DeleteDeviceParams{})
+	httpResp, err := r.client.DeleteDevice(ctx, deviceID, &v20260501.DeleteDeviceParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
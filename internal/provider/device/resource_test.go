@@ -1,17 +1,25 @@
 package device
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 	"github.com/smallstep/terraform-provider-smallstep/internal/testprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var provider = &testprovider.SmallstepTestProvider{
@@ -66,7 +74,7 @@ resource "smallstep_device" "laptop1" {
 `
 
 func TestAccDeviceResource(t *testing.T) {
-	permanentID := uuid.NewString()
+	permanentID := "tfprovider-" + uuid.NewString()
 
 	// min -> max
 	helper.Test(t, helper.TestCase{
@@ -119,7 +127,7 @@ func TestAccDeviceResource(t *testing.T) {
 	})
 
 	// min -> empty
-	permanentID1 := uuid.NewString()
+	permanentID1 := "tfprovider-" + uuid.NewString()
 	helper.Test(t, helper.TestCase{
 		ProtoV6ProviderFactories: providerFactories,
 		Steps: []helper.TestStep{
@@ -148,7 +156,7 @@ func TestAccDeviceResource(t *testing.T) {
 		},
 	})
 
-	permanentID2 := uuid.NewString()
+	permanentID2 := "tfprovider-" + uuid.NewString()
 
 	// max -> min
 	helper.Test(t, helper.TestCase{
@@ -197,7 +205,7 @@ func TestAccDeviceResource(t *testing.T) {
 		},
 	})
 
-	permanentID3 := uuid.NewString()
+	permanentID3 := "tfprovider-" + uuid.NewString()
 
 	// max -> empty
 	helper.Test(t, helper.TestCase{
@@ -248,7 +256,7 @@ func TestAccDeviceResource(t *testing.T) {
 		},
 	})
 
-	permanentID4 := uuid.NewString()
+	permanentID4 := "tfprovider-" + uuid.NewString()
 
 	// empty -> min
 	helper.Test(t, helper.TestCase{
@@ -302,7 +310,7 @@ func TestAccDeviceResource(t *testing.T) {
 	})
 
 	// empty -> max
-	permanentID5 := uuid.NewString()
+	permanentID5 := "tfprovider-" + uuid.NewString()
 
 	helper.Test(t, helper.TestCase{
 		ProtoV6ProviderFactories: providerFactories,
@@ -333,7 +341,7 @@ func TestAccDeviceResource(t *testing.T) {
 
 	// user.email can be set on create and updated via PATCH, but it can no
 	// longer be unset. Create without a user, then add one via update.
-	permanentID6 := uuid.NewString()
+	permanentID6 := "tfprovider-" + uuid.NewString()
 	helper.Test(t, helper.TestCase{
 		ProtoV6ProviderFactories: providerFactories,
 		Steps: []helper.TestStep{
@@ -360,3 +368,59 @@ func TestAccDeviceResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDeviceResourceShared(t *testing.T) {
+	permanentID := "tfprovider-" + uuid.NewString()
+	config := fmt.Sprintf(`
+resource "smallstep_device" "laptop1" {
+	permanent_identifier = %q
+	shared = true
+}`, permanentID)
+
+	steps := []helper.TestStep{
+		{
+			Config: config,
+			Check:  helper.TestCheckResourceAttr("smallstep_device.laptop1", "shared", "true"),
+		},
+		{
+			Config: fmt.Sprintf(minConfig, permanentID),
+			Check:  helper.TestCheckNoResourceAttr("smallstep_device.laptop1", "shared"),
+		},
+	}
+	if utils.APIVersionFromEnv() == clientset.Version20250101 {
+		steps = []helper.TestStep{{
+			Config:      config,
+			ExpectError: regexp.MustCompile(`Unsupported Smallstep API Version`),
+		}}
+	}
+
+	helper.Test(t, helper.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps:                    steps,
+	})
+}
+
+func TestUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := NewResource().(*Resource)
+	upgraders := r.UpgradeState(ctx)
+	require.Contains(t, upgraders, int64(0))
+	upgrader := upgraders[0]
+	assert.NotContains(t, upgrader.PriorSchema.Attributes, "shared")
+
+	prior := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	require.False(t, prior.SetAttribute(ctx, path.Root("id"), "c0ffee").HasError())
+	require.False(t, prior.SetAttribute(ctx, path.Root("permanent_identifier"), "laptop").HasError())
+
+	resp := &resource.UpgradeStateResponse{State: utils.NullState(t, r)}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	got := Model{}
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "laptop", got.PermanentIdentifier.ValueString())
+	assert.True(t, got.Shared.IsNull())
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -23,7 +23,7 @@ func NewDataSource() datasource.DataSource {
 }
 
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (ds *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,11 +47,11 @@ func (ds *DataSource) Configure(ctx context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	ds.client = clients.V20250101
+	ds.client = clients.Pinned
 }
 
 func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	ethernet, props, err := utils.Describe("ethernet")
+	ethernet, props, err := utils.DescribeV20260501("ethernet")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Ethernet Schema",
@@ -90,6 +90,8 @@ func (ds *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 }
 
 func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+name, "read")
+
 	var id string
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
@@ -97,7 +99,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	httpResp, err := ds.client.GetEthernet(ctx, id, &v20250101.GetEthernetParams{})
+	httpResp, err := ds.client.GetEthernet(ctx, id, &v20260501.GetEthernetParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -120,7 +122,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	ethernet := &v20250101.Ethernet{}
+	ethernet := &v20260501.Ethernet{}
 	if err := json.NewDecoder(httpResp.Body).Decode(ethernet); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
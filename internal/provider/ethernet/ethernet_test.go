@@ -1,13 +1,6 @@
 package ethernet
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
-	"io"
-	"log"
-	"net/http"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -15,8 +8,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
-	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 	"github.com/smallstep/terraform-provider-smallstep/internal/testprovider"
 )
 
@@ -36,54 +27,3 @@ var providerFactories = map[string]func() (tfprotov6.ProviderServer, error){
 func TestMain(m *testing.M) {
 	helper.TestMain(m)
 }
-
-func init() {
-	helper.AddTestSweepers("smallstep_ethernet", &helper.Sweeper{
-		Name: "smallstep_ethernet",
-		F: func(region string) error {
-			ctx := context.Background()
-
-			client, err := utils.SmallstepAPIClientFromEnv()
-			if err != nil {
-				return err
-			}
-
-			resp, err := client.ListEthernet(ctx, &v20250101.ListEthernetParams{})
-			if err != nil {
-				return fmt.Errorf("list ethernet: %w", err)
-			}
-			defer resp.Body.Close()
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return fmt.Errorf("read list ethernet response body: %w", err)
-			}
-			if resp.StatusCode != http.StatusOK {
-				return fmt.Errorf("failed to list ethernet: %d: %s", resp.StatusCode, body)
-			}
-
-			var list []*v20250101.Ethernet
-			if err := json.Unmarshal(body, &list); err != nil {
-				return fmt.Errorf("failed to parse ethernet list: %w", err)
-			}
-
-			for _, ethernet := range list {
-				if ethernet.Name == nil || !strings.HasPrefix(*ethernet.Name, "tfprovider") {
-					continue
-				}
-
-				resp, err := client.DeleteEthernet(ctx, *ethernet.Id, &v20250101.DeleteEthernetParams{})
-				if err != nil {
-					return fmt.Errorf("failed to delete ethernet %q: %w", *ethernet.Id, err)
-				}
-				defer resp.Body.Close()
-				if resp.StatusCode != http.StatusNoContent {
-					body, _ := io.ReadAll(resp.Body)
-					return fmt.Errorf("failed to delete ethernet %q: %d: %s", *ethernet.Id, resp.StatusCode, body)
-				}
-				log.Printf("Successfully swept ethernet %s\n", *ethernet.Name)
-			}
-
-			return nil
-		},
-	})
-}
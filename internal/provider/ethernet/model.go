@@ -7,7 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -21,8 +21,8 @@ type EthernetModel struct {
 	Credentials    types.Set    `tfsdk:"credentials"`
 }
 
-func (model *EthernetModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20250101.Ethernet {
-	ethernet := &v20250101.Ethernet{
+func (model *EthernetModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20260501.Ethernet {
+	ethernet := &v20260501.Ethernet{
 		Name:           model.Name.ValueStringPointer(),
 		RadiusServerCA: model.RadiusServerCA.ValueString(),
 		Autojoin:       model.Autojoin.ValueBoolPointer(),
@@ -38,7 +38,7 @@ func (model *EthernetModel) ToAPI(ctx context.Context, diags *diag.Diagnostics)
 	return ethernet
 }
 
-func FromAPI(ctx context.Context, ethernet *v20250101.Ethernet, diags *diag.Diagnostics, state utils.AttributeGetter) *EthernetModel {
+func FromAPI(ctx context.Context, ethernet *v20260501.Ethernet, diags *diag.Diagnostics, state utils.AttributeGetter) *EthernetModel {
 	model := &EthernetModel{
 		ID:             types.StringPointerValue(ethernet.Id),
 		RadiusServerCA: types.StringValue(ethernet.RadiusServerCA),
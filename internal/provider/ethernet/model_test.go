@@ -0,0 +1,33 @@
+package ethernet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+
+	utils.RoundTrip[v20260501.Ethernet]{
+		Version: "2026-05-01",
+		Schema:  "ethernet",
+		Convert: func(t *testing.T, ethernet *v20260501.Ethernet) any {
+			var diags diag.Diagnostics
+			model := FromAPI(ctx, ethernet, &diags, state)
+			got := model.ToAPI(ctx, &diags)
+			require.False(t, diags.HasError(), diags)
+			return got
+		},
+		Want: func(t *testing.T, ethernet *v20260501.Ethernet) any {
+			// The ID is read-only.
+			ethernet.Id = nil
+			return ethernet
+		},
+	}.Run(t)
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
@@ -24,11 +26,11 @@ func NewResource() resource.Resource {
 }
 
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	ethernet, props, err := utils.Describe("ethernet")
+	ethernet, props, err := utils.DescribeV20260501("ethernet")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Ethernet Schema",
@@ -59,6 +61,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"radius_server_ca": schema.StringAttribute{
 				MarkdownDescription: props["radiusServerCA"],
 				Required:            true,
+				Validators: []validator.String{
+					validators.PEMCertificates(),
+				},
 			},
 			"autojoin": schema.BoolAttribute{
 				MarkdownDescription: props["autojoin"],
@@ -94,10 +99,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, name, "read")
+
 	state := &EthernetModel{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -114,7 +121,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	httpResp, err := r.client.GetEthernet(ctx, ethernetID, &v20250101.GetEthernetParams{})
+	httpResp, err := r.client.GetEthernet(ctx, ethernetID, &v20260501.GetEthernetParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -137,7 +144,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	ethernet := &v20250101.Ethernet{}
+	ethernet := &v20260501.Ethernet{}
 	if err := json.NewDecoder(httpResp.Body).Decode(ethernet); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -155,6 +162,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "create")
+
 	plan := &EthernetModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -166,7 +175,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PostEthernet(ctx, &v20250101.PostEthernetParams{}, *reqBody)
+	httpResp, err := r.client.PostEthernet(ctx, &v20260501.PostEthernetParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -185,7 +194,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	ethernet := &v20250101.Ethernet{}
+	ethernet := &v20260501.Ethernet{}
 	if err := json.NewDecoder(httpResp.Body).Decode(ethernet); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -203,6 +212,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "update")
+
 	plan := &EthernetModel{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
@@ -216,7 +227,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PutEthernet(ctx, ethernetID, &v20250101.PutEthernetParams{}, *reqBody)
+	httpResp, err := r.client.PutEthernet(ctx, ethernetID, &v20260501.PutEthernetParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -240,7 +251,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	ethernet := &v20250101.Ethernet{}
+	ethernet := &v20260501.Ethernet{}
 	if err := json.NewDecoder(httpResp.Body).Decode(ethernet); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -256,6 +267,8 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, name, "delete")
+
 	state := &EthernetModel{}
 	diags := req.State.Get(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -272,7 +285,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.DeleteEthernet(ctx, ethernetID, &v20250101.DeleteEthernetParams{})
+	httpResp, err := r.client.DeleteEthernet(ctx, ethernetID, &v20260501.DeleteEthernetParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
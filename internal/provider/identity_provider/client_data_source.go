@@ -76,6 +76,8 @@ func (d *ClientDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 }
 
 func (ds *ClientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+client_name, "read")
+
 	var id string
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
@@ -108,6 +108,8 @@ func (r *ClientResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *ClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, client_name, "read")
+
 	state := &ClientModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
 	if resp.Diagnostics.HasError() {
@@ -165,6 +167,8 @@ func (r *ClientResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (a *ClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, client_name, "create")
+
 	plan := &ClientModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -220,6 +224,8 @@ func (a *ClientResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *ClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, client_name, "update")
+
 	resp.Diagnostics.AddError(
 		"Update not supported",
 		"All changes to an identity provider client require replacement",
@@ -227,6 +233,8 @@ func (r *ClientResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *ClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, client_name, "delete")
+
 	var id string
 	diags := req.State.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
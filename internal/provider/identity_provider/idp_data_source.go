@@ -87,6 +87,8 @@ func (d *IdentityProviderDataSource) Schema(ctx context.Context, req datasource.
 }
 
 func (ds *IdentityProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+idp_name, "read")
+
 	httpResp, err := ds.client.GetIdentityProvider(ctx, &v20250101.GetIdentityProviderParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -11,9 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
 	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*IdentityProviderResource)(nil)
@@ -43,6 +45,9 @@ func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.Sche
 			"trust_roots": schema.StringAttribute{
 				MarkdownDescription: props["trustRoots"],
 				Required:            true,
+				Validators: []validator.String{
+					validators.PEMCertificates(),
+				},
 			},
 			"issuer": schema.StringAttribute{
 				MarkdownDescription: props["issuer"],
@@ -101,6 +106,8 @@ func (r *IdentityProviderResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *IdentityProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, idp_name, "read")
+
 	httpResp, err := r.client.GetIdentityProvider(ctx, &v20250101.GetIdentityProviderParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -139,6 +146,8 @@ func (r *IdentityProviderResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (a *IdentityProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, idp_name, "create")
+
 	plan := &IdentityProviderModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -210,6 +219,8 @@ func (a *IdentityProviderResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *IdentityProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, idp_name, "update")
+
 	plan := &IdentityProviderModel{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
@@ -254,6 +265,8 @@ func (r *IdentityProviderResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *IdentityProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, idp_name, "delete")
+
 	httpResp, err := r.client.DeleteIdentityProvider(ctx, &v20250101.DeleteIdentityProviderParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
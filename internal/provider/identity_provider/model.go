@@ -3,10 +3,12 @@ package identity_provider
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 )
 
 const idp_name = "smallstep_identity_provider"
 const client_name = "smallstep_identity_provider_client"
+const sso_integration_name = "smallstep_sso_integration"
 
 type IdentityProviderModel struct {
 	TrustRoots        types.String `tfsdk:"trust_roots"`
@@ -24,6 +26,15 @@ type ClientModel struct {
 	WriteSecretFile types.String `tfsdk:"write_secret_file"`
 }
 
+type SSOIntegrationModel struct {
+	ID                  types.String `tfsdk:"id"`
+	RedirectURI         types.String `tfsdk:"redirect_uri"`
+	LifecycleFailureURI types.String `tfsdk:"lifecycle_failure_uri"`
+	Secret              types.String `tfsdk:"secret"`
+	StoreSecret         types.Bool   `tfsdk:"store_secret"`
+	WriteSecretFile     types.String `tfsdk:"write_secret_file"`
+}
+
 func idpToAPI(model *IdentityProviderModel) v20250101.IdentityProvider {
 	return v20250101.IdentityProvider{
 		TrustRoots: model.TrustRoots.ValueString(),
@@ -54,3 +65,33 @@ func clientFromAPI(client *v20250101.IdpClient) ClientModel {
 		Secret:      types.StringPointerValue(client.Secret),
 	}
 }
+
+func ssoIntegrationToAPI(model *SSOIntegrationModel) v20260501.SsoIntegration {
+	return v20260501.SsoIntegration{
+		Id:                  model.ID.ValueStringPointer(),
+		RedirectURI:         model.RedirectURI.ValueString(),
+		LifecycleFailureURI: model.LifecycleFailureURI.ValueStringPointer(),
+	}
+}
+
+func ssoIntegrationFromAPI(integration *v20260501.SsoIntegration) SSOIntegrationModel {
+	return SSOIntegrationModel{
+		ID:                  types.StringPointerValue(integration.Id),
+		RedirectURI:         types.StringValue(integration.RedirectURI),
+		LifecycleFailureURI: types.StringPointerValue(integration.LifecycleFailureURI),
+		Secret:              types.StringPointerValue(integration.Secret),
+	}
+}
+
+// ssoIntegrationFromClient maps the state of an identity provider client to
+// the state of an SSO integration with the same ID and secret.
+func ssoIntegrationFromClient(client *ClientModel) SSOIntegrationModel {
+	return SSOIntegrationModel{
+		ID:                  client.ID,
+		RedirectURI:         client.RedirectURI,
+		LifecycleFailureURI: types.StringNull(),
+		Secret:              client.Secret,
+		StoreSecret:         client.StoreSecret,
+		WriteSecretFile:     client.WriteSecretFile,
+	}
+}
@@ -0,0 +1,60 @@
+package identity_provider
+
+import (
+	"testing"
+
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+)
+
+func TestIdentityProviderModelRoundTrip(t *testing.T) {
+	utils.RoundTrip[v20250101.IdentityProvider]{
+		Version: "2025-01-01",
+		Schema:  "identityProvider",
+		Convert: func(t *testing.T, idp *v20250101.IdentityProvider) any {
+			model := idpFromAPI(idp)
+			return idpToAPI(&model)
+		},
+		Want: func(t *testing.T, idp *v20250101.IdentityProvider) any {
+			// The endpoints are read-only.
+			idp.Issuer = nil
+			idp.AuthorizeEndpoint = nil
+			idp.TokenEndpoint = nil
+			idp.JwksEndpoint = nil
+			return idp
+		},
+	}.Run(t)
+}
+
+func TestClientModelRoundTrip(t *testing.T) {
+	utils.RoundTrip[v20250101.IdpClient]{
+		Version: "2025-01-01",
+		Schema:  "idpClient",
+		Convert: func(t *testing.T, client *v20250101.IdpClient) any {
+			model := clientFromAPI(client)
+			return clientToAPI(&model)
+		},
+		Want: func(t *testing.T, client *v20250101.IdpClient) any {
+			// The secret is read-only.
+			client.Secret = nil
+			return client
+		},
+	}.Run(t)
+}
+
+func TestSSOIntegrationModelRoundTrip(t *testing.T) {
+	utils.RoundTrip[v20260501.SsoIntegration]{
+		Version: "2026-05-01",
+		Schema:  "ssoIntegration",
+		Convert: func(t *testing.T, integration *v20260501.SsoIntegration) any {
+			model := ssoIntegrationFromAPI(integration)
+			return ssoIntegrationToAPI(&model)
+		},
+		Want: func(t *testing.T, integration *v20260501.SsoIntegration) any {
+			// The secret is read-only.
+			integration.Secret = nil
+			return integration
+		},
+	}.Run(t)
+}
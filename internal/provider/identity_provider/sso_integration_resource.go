@@ -0,0 +1,389 @@
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+)
+
+var _ resource.ResourceWithImportState = (*SSOIntegrationResource)(nil)
+var _ resource.ResourceWithMoveState = (*SSOIntegrationResource)(nil)
+
+func NewSSOIntegrationResource() resource.Resource {
+	return &SSOIntegrationResource{}
+}
+
+// SSOIntegrationResource manages the SSO integrations of the 2026-05-01 API,
+// which replace identity provider clients.
+type SSOIntegrationResource struct {
+	client *v20260501.Client
+}
+
+func (r *SSOIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	integration, props, err := utils.DescribeV20260501("ssoIntegration")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Smallstep OpenAPI SSO Integration Schema",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: integration,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: props["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redirect_uri": schema.StringAttribute{
+				MarkdownDescription: props["redirectURI"],
+				Required:            true,
+			},
+			"lifecycle_failure_uri": schema.StringAttribute{
+				MarkdownDescription: props["lifecycleFailureURI"],
+				Optional:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: props["secret"],
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"store_secret": schema.BoolAttribute{
+				MarkdownDescription: "Whether to store the client_secret in terraform state when it is created. The secret cannot be recovered later.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"write_secret_file": schema.StringAttribute{
+				MarkdownDescription: "If non-empty the client_secret will be written to this filepath when it is created. The secret cannot be recovered later.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SSOIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = sso_integration_name
+}
+
+// Configure adds the Smallstep API client to the resource.
+func (r *SSOIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*clientset.Clients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Get Smallstep API client from provider",
+			fmt.Sprintf("Expected *clientset.Clients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.V20260501
+}
+
+// movedFromClientKey is the private state key set on an SSO integration moved
+// from a smallstep_identity_provider_client until it is first read.
+const movedFromClientKey = "moved_from_client"
+
+// MoveState supports moved blocks from smallstep_identity_provider_client.
+// The client's ID is kept, assuming the 2026-05-01 API serves the clients of
+// earlier versions as SSO integrations with the same ID. Nothing guarantees
+// that yet, so the first Read after a move fails instead of removing the
+// integration from state when the ID is not found, which would recreate it
+// with a new secret.
+func (r *SSOIntegrationResource) MoveState(ctx context.Context) []resource.StateMover {
+	clientSchema := &resource.SchemaResponse{}
+	(&ClientResource{}).Schema(ctx, resource.SchemaRequest{}, clientSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &clientSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != client_name {
+					return
+				}
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Invalid Move Identity Provider Client Request",
+						"The identity provider client state could not be read.",
+					)
+					return
+				}
+
+				client := &ClientModel{}
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, client)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				model := ssoIntegrationFromClient(client)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, model)...)
+				resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, movedFromClientKey, []byte("true"))...)
+			},
+		},
+	}
+}
+
+func (r *SSOIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, sso_integration_name, "read")
+
+	state := &SSOIntegrationModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Read SSO Integration Request",
+			"ID is required.",
+		)
+		return
+	}
+
+	httpResp, err := r.client.GetSsoIntegration(ctx, id, &v20260501.GetSsoIntegrationParams{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to read SSO integration: %v", err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	moved, diags := req.Private.GetKey(ctx, movedFromClientKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		if moved != nil {
+			resp.Diagnostics.AddError(
+				"SSO Integration Not Found After Move",
+				fmt.Sprintf("The identity provider client %q was moved to smallstep_sso_integration, but the API has no SSO integration with that ID. "+
+					"It was not removed from state, since creating a new integration would change the client secret. "+
+					"Remove the moved block to keep managing the client, or import the SSO integration that replaced it.", id),
+			)
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d reading SSO integration %q: %s", reqID, httpResp.StatusCode, id, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+	if moved != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, movedFromClientKey, nil)...)
+	}
+
+	integration := &v20260501.SsoIntegration{}
+	if err := json.NewDecoder(httpResp.Body).Decode(integration); err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to unmarshal SSO integration: %v", err),
+		)
+		return
+	}
+
+	remote := ssoIntegrationFromAPI(integration)
+	remote.Secret = state.Secret
+	remote.StoreSecret = state.StoreSecret
+	remote.WriteSecretFile = state.WriteSecretFile
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, remote)...)
+}
+
+func (r *SSOIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, sso_integration_name, "create")
+
+	plan := &SSOIntegrationModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := ssoIntegrationToAPI(plan)
+
+	httpResp, err := r.client.PostSsoIntegrations(ctx, &v20260501.PostSsoIntegrationsParams{}, reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to create SSO integration: %v", err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusCreated {
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d creating SSO integration: %s", reqID, httpResp.StatusCode, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+
+	integration := &v20260501.SsoIntegration{}
+	if err := json.NewDecoder(httpResp.Body).Decode(integration); err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to unmarshal SSO integration: %v", err),
+		)
+		return
+	}
+
+	model := ssoIntegrationFromAPI(integration)
+
+	if !plan.StoreSecret.ValueBool() {
+		model.Secret = types.StringNull()
+	}
+	if file := plan.WriteSecretFile.ValueString(); file != "" {
+		if err := os.WriteFile(file, []byte(utils.Deref(integration.Secret)), 0600); err != nil {
+			resp.Diagnostics.AddError("Write client_secret to file", err.Error())
+		}
+	}
+	model.StoreSecret = plan.StoreSecret
+	model.WriteSecretFile = plan.WriteSecretFile
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *SSOIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, sso_integration_name, "update")
+
+	plan := &SSOIntegrationModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &SSOIntegrationModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Update SSO Integration Request",
+			"ID is required.",
+		)
+		return
+	}
+
+	reqBody := ssoIntegrationToAPI(plan)
+
+	httpResp, err := r.client.PutSsoIntegration(ctx, id, &v20260501.PutSsoIntegrationParams{}, reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to update SSO integration: %v", err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d updating SSO integration %q: %s", reqID, httpResp.StatusCode, id, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+
+	integration := &v20260501.SsoIntegration{}
+	if err := json.NewDecoder(httpResp.Body).Decode(integration); err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to unmarshal SSO integration: %v", err),
+		)
+		return
+	}
+
+	// The secret is only available when the integration is created.
+	model := ssoIntegrationFromAPI(integration)
+	model.Secret = state.Secret
+	model.StoreSecret = state.StoreSecret
+	model.WriteSecretFile = state.WriteSecretFile
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *SSOIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, sso_integration_name, "delete")
+
+	var id string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Delete SSO Integration Request",
+			"ID is required.",
+		)
+		return
+	}
+
+	httpResp, err := r.client.DeleteSsoIntegration(ctx, id, &v20260501.DeleteSsoIntegrationParams{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Smallstep API Client Error",
+			fmt.Sprintf("Failed to delete SSO integration: %v", err),
+		)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusNoContent {
+		reqID := httpResp.Header.Get("X-Request-Id")
+		resp.Diagnostics.AddError(
+			"Smallstep API Response Error",
+			fmt.Sprintf("Request %q received status %d deleting SSO integration %q: %s", reqID, httpResp.StatusCode, id, utils.APIErrorMsg(httpResp.Body)),
+		)
+		return
+	}
+}
+
+func (r *SSOIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
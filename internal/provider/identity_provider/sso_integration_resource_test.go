@@ -0,0 +1,252 @@
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccSSOIntegration(t *testing.T) {
+	require.NoError(t, sweep())
+
+	ca, _ := utils.CACerts(t)
+
+	const config = `
+resource "smallstep_identity_provider" "my_idp" {
+	trust_roots = %q
+}
+
+resource "smallstep_sso_integration" "my_integration" {
+	redirect_uri = "https://example.com/callback"
+	store_secret = true
+	depends_on = [ smallstep_identity_provider.my_idp ]
+}
+`
+	const config2 = `
+resource "smallstep_identity_provider" "my_idp" {
+	trust_roots = %q
+}
+
+resource "smallstep_sso_integration" "my_integration" {
+	redirect_uri = "https://example.com/callback2"
+	lifecycle_failure_uri = "https://example.com/inactive"
+	store_secret = true
+	depends_on = [ smallstep_identity_provider.my_idp ]
+}
+`
+	helper.Test(t, helper.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []helper.TestStep{
+			{
+				Config: fmt.Sprintf(config, ca),
+				Check: helper.ComposeAggregateTestCheckFunc(
+					helper.TestCheckResourceAttr("smallstep_sso_integration.my_integration", "redirect_uri", "https://example.com/callback"),
+					helper.TestMatchResourceAttr("smallstep_sso_integration.my_integration", "id", utils.UUIDRegexp),
+					helper.TestMatchResourceAttr("smallstep_sso_integration.my_integration", "secret", regexp.MustCompile(`\w+`)),
+					helper.TestCheckNoResourceAttr("smallstep_sso_integration.my_integration", "lifecycle_failure_uri"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config2, ca),
+				ConfigPlanChecks: helper.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("smallstep_sso_integration.my_integration", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: helper.ComposeAggregateTestCheckFunc(
+					helper.TestCheckResourceAttr("smallstep_sso_integration.my_integration", "redirect_uri", "https://example.com/callback2"),
+					helper.TestCheckResourceAttr("smallstep_sso_integration.my_integration", "lifecycle_failure_uri", "https://example.com/inactive"),
+					helper.TestMatchResourceAttr("smallstep_sso_integration.my_integration", "secret", regexp.MustCompile(`\w+`)),
+				),
+			},
+			{
+				ResourceName:            "smallstep_sso_integration.my_integration",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "store_secret"},
+			},
+		},
+	})
+}
+
+func TestAccSSOIntegrationMovedFromClient(t *testing.T) {
+	require.NoError(t, sweep())
+
+	ca, _ := utils.CACerts(t)
+
+	const config = `
+resource "smallstep_identity_provider" "my_idp" {
+	trust_roots = %q
+}
+
+resource "smallstep_identity_provider_client" "my_idp_client" {
+	redirect_uri = "https://example.com/callback"
+	store_secret = true
+	depends_on = [ smallstep_identity_provider.my_idp ]
+}
+`
+	const config2 = `
+resource "smallstep_identity_provider" "my_idp" {
+	trust_roots = %q
+}
+
+moved {
+	from = smallstep_identity_provider_client.my_idp_client
+	to   = smallstep_sso_integration.my_integration
+}
+
+resource "smallstep_sso_integration" "my_integration" {
+	redirect_uri = "https://example.com/callback"
+	store_secret = true
+	depends_on = [ smallstep_identity_provider.my_idp ]
+}
+`
+	var id, secret string
+	helper.Test(t, helper.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []helper.TestStep{
+			{
+				Config: fmt.Sprintf(config, ca),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["smallstep_identity_provider_client.my_idp_client"]
+					if !ok {
+						return errors.New("identity provider client not found in state")
+					}
+					id = rs.Primary.Attributes["id"]
+					secret = rs.Primary.Attributes["secret"]
+					return nil
+				},
+			},
+			{
+				Config: fmt.Sprintf(config2, ca),
+				ConfigPlanChecks: helper.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("smallstep_sso_integration.my_integration", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: helper.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						return helper.TestCheckResourceAttr("smallstep_sso_integration.my_integration", "id", id)(s)
+					},
+					func(s *terraform.State) error {
+						return helper.TestCheckResourceAttr("smallstep_sso_integration.my_integration", "secret", secret)(s)
+					},
+					helper.TestCheckResourceAttr("smallstep_sso_integration.my_integration", "redirect_uri", "https://example.com/callback"),
+					helper.TestCheckResourceAttr("smallstep_sso_integration.my_integration", "store_secret", "true"),
+				),
+			},
+		},
+	})
+}
+
+// moveClient moves an identity provider client with the given state to a
+// smallstep_sso_integration through the provider server, like a moved block.
+func moveClient(t *testing.T, server tfprotov6.ProviderServer, sourceTypeName string, client map[string]any) *tfprotov6.MoveResourceStateResponse {
+	t.Helper()
+	b, err := json.Marshal(client)
+	require.NoError(t, err)
+	resp, err := server.MoveResourceState(context.Background(), &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/smallstep/smallstep",
+		SourceTypeName:        sourceTypeName,
+		SourceState:           &tfprotov6.RawState{JSON: b},
+		TargetTypeName:        sso_integration_name,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestSSOIntegrationMoveState(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerFactories["smallstep"]()
+	require.NoError(t, err)
+
+	client := map[string]any{
+		"id":                "0b8a4f4e-1f4c-4bd6-a0c8-8fb8f0f8b6a1",
+		"redirect_uri":      "https://example.com/callback",
+		"secret":            "s3cr3t",
+		"store_secret":      true,
+		"write_secret_file": nil,
+	}
+	resp := moveClient(t, server, client_name, client)
+	require.Empty(t, resp.Diagnostics)
+
+	r := NewSSOIntegrationResource()
+	state := utils.NullState(t, r)
+	raw, err := resp.TargetState.Unmarshal(state.Schema.Type().TerraformType(ctx))
+	require.NoError(t, err)
+	state.Raw = raw
+	got := &SSOIntegrationModel{}
+	require.False(t, state.Get(ctx, got).HasError())
+	assert.Equal(t, SSOIntegrationModel{
+		ID:                  types.StringValue("0b8a4f4e-1f4c-4bd6-a0c8-8fb8f0f8b6a1"),
+		RedirectURI:         types.StringValue("https://example.com/callback"),
+		LifecycleFailureURI: types.StringNull(),
+		Secret:              types.StringValue("s3cr3t"),
+		StoreSecret:         types.BoolValue(true),
+		WriteSecretFile:     types.StringNull(),
+	}, *got)
+	assert.Contains(t, string(resp.TargetPrivate), movedFromClientKey)
+
+	// Other resource types are left to other movers.
+	resp = moveClient(t, server, "smallstep_provisioner", client)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, "Unable to Move Resource State", resp.Diagnostics[0].Summary)
+}
+
+func TestSSOIntegrationReadAfterMove(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerFactories["smallstep"]()
+	require.NoError(t, err)
+	providerConfig, err := tfprotov6.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+	require.NoError(t, err)
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	require.NoError(t, err)
+	require.Empty(t, configureResp.Diagnostics)
+
+	read := func(id string) *tfprotov6.ReadResourceResponse {
+		moveResp := moveClient(t, server, client_name, map[string]any{
+			"id":           id,
+			"redirect_uri": "https://example.com/callback",
+			"store_secret": false,
+		})
+		require.Empty(t, moveResp.Diagnostics)
+		resp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+			TypeName:     sso_integration_name,
+			CurrentState: moveResp.TargetState,
+			Private:      moveResp.TargetPrivate,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("found", func(t *testing.T) {
+		client := utils.NewIdentityProviderClient(t)
+		resp := read(*client.Id)
+		require.Empty(t, resp.Diagnostics)
+		assert.NotContains(t, string(resp.Private), movedFromClientKey)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp := read("4f1c3a7e-2b6d-4e8f-9a0b-1c2d3e4f5a6b")
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Equal(t, tfprotov6.DiagnosticSeverityError, resp.Diagnostics[0].Severity)
+		assert.Equal(t, "SSO Integration Not Found After Move", resp.Diagnostics[0].Summary)
+	})
+}
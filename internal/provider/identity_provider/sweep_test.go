@@ -21,6 +21,7 @@ var provider = &testprovider.SmallstepTestProvider{
 	ResourceFactories: []func() resource.Resource{
 		NewIdentityProviderResource,
 		NewClientResource,
+		NewSSOIntegrationResource,
 	},
 	DataSourceFactories: []func() datasource.DataSource{
 		NewIdentityProviderDataSource,
@@ -36,15 +37,8 @@ func TestMain(m *testing.M) {
 	helper.TestMain(m)
 }
 
-func init() {
-	helper.AddTestSweepers("smallstep_identity_provider", &helper.Sweeper{
-		Name: "smallstep_identity_provider",
-		F: func(region string) error {
-			return sweep()
-		},
-	})
-}
-
+// sweep deletes the team's identity provider so tests can create one, since a
+// team can only have one.
 func sweep() error {
 	ctx := context.Background()
 
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"maps"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sensitiveHeaders are masked when request and response headers are logged.
+var sensitiveHeaders = []string{
+	"http.request.header.authorization",
+	"http.request.header.cookie",
+	"http.response.header.set-cookie",
+}
+
+// sensitiveValues are masked in every logged field, including request and
+// response bodies: JSON string properties whose name refers to a secret,
+// password, token or private key, PEM private keys and bearer tokens.
+var sensitiveValues = []*regexp.Regexp{
+	regexp.MustCompile(`"[^"]*(?i:secret|password|passphrase|token|privatekey|encryptedkey)[^"]*"\s*:\s*"(?:[^"\\]|\\.)*"`),
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[^-]*-----END [A-Z ]*PRIVATE KEY-----`),
+	regexp.MustCompile(`Bearer [A-Za-z0-9\-._~+/]+=*`),
+}
+
+// loggingTransport logs every request to the Smallstep API. The method, path,
+// status, latency and request ID are logged at DEBUG level and headers and
+// bodies at TRACE level, with sensitive values masked.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := maskSensitive(r.Context())
+
+	fields := map[string]any{
+		"http.method": r.Method,
+		"http.path":   r.URL.Path,
+	}
+	if r.URL.RawQuery != "" {
+		fields["http.query"] = r.URL.RawQuery
+	}
+
+	traceFields := maps.Clone(fields)
+	addHeaderFields(traceFields, "http.request.header.", r.Header)
+	if r.GetBody != nil {
+		if body, err := r.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			body.Close()
+			traceFields["http.request.body"] = string(b)
+		}
+	}
+	tflog.Trace(ctx, "Sending Smallstep API request", traceFields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(r)
+	fields["http.duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Smallstep API request failed", fields)
+		return nil, err
+	}
+
+	fields["http.status_code"] = resp.StatusCode
+	fields["http.request_id"] = resp.Header.Get("X-Request-Id")
+	tflog.Debug(ctx, "Smallstep API request", fields)
+
+	// Buffer the response so it can be logged and still be read by the
+	// caller. API responses are small JSON documents.
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+
+	traceFields = maps.Clone(fields)
+	addHeaderFields(traceFields, "http.response.header.", resp.Header)
+	traceFields["http.response.body"] = string(b)
+	tflog.Trace(ctx, "Received Smallstep API response", traceFields)
+
+	return resp, nil
+}
+
+func addHeaderFields(fields map[string]any, prefix string, h http.Header) {
+	for k, v := range h {
+		fields[prefix+strings.ToLower(k)] = strings.Join(v, ", ")
+	}
+}
+
+func maskSensitive(ctx context.Context) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveHeaders...)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, sensitiveValues...)
+	return ctx
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -23,7 +23,7 @@ func NewDataSource() datasource.DataSource {
 }
 
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (a *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,11 +47,11 @@ func (ds *DataSource) Configure(ctx context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	ds.client = clients.V20250101
+	ds.client = clients.Pinned
 }
 
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	radius, props, err := utils.Describe("managedRadius")
+	radius, props, err := utils.DescribeV20260501("managedRadius")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Managed RADIUS Schema",
@@ -60,7 +60,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 		return
 	}
 
-	replyAttrs, replyAttrsProps, err := utils.Describe("replyAttribute")
+	replyAttrs, replyAttrsProps, err := utils.DescribeV20260501("replyAttribute")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Reply Attributes Schema",
@@ -132,6 +132,8 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 }
 
 func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+name, "read")
+
 	var id string
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
@@ -139,7 +141,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	httpResp, err := ds.client.GetManagedRadius(ctx, id, &v20250101.GetManagedRadiusParams{})
+	httpResp, err := ds.client.GetManagedRadius(ctx, id, &v20260501.GetManagedRadiusParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -162,7 +164,7 @@ func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	managedRadius := &v20250101.ManagedRadius{}
+	managedRadius := &v20260501.ManagedRadius{}
 	if err := json.NewDecoder(httpResp.Body).Decode(managedRadius); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -27,6 +27,13 @@ type ManagedRadiusModel struct {
 	ServerHostname types.String `tfsdk:"server_hostname"`
 }
 
+// ResourceModel adds the attributes of the managed RADIUS resource that are
+// only tracked in state to the model shared with the data source.
+type ResourceModel struct {
+	ManagedRadiusModel
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 type ReplyAttributeModel struct {
 	Name                 types.String `tfsdk:"name"`
 	Value                types.String `tfsdk:"value"`
@@ -39,7 +46,7 @@ var replyAttributeTypes = map[string]attr.Type{
 	"value_from_certificate": types.StringType,
 }
 
-func (model *ManagedRadiusModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) v20250101.ManagedRadius {
+func (model *ManagedRadiusModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) v20260501.ManagedRadius {
 	var nasIPs []string
 	ds := model.NASIPs.ElementsAs(ctx, &nasIPs, false)
 	diags.Append(ds...)
@@ -48,16 +55,16 @@ func (model *ManagedRadiusModel) ToAPI(ctx context.Context, diags *diag.Diagnost
 	ds = model.ReplyAttributes.ElementsAs(ctx, &replyAttrModels, false)
 	diags.Append(ds...)
 
-	replyAttrs := make([]v20250101.ReplyAttribute, len(replyAttrModels))
+	replyAttrs := make([]v20260501.ReplyAttribute, len(replyAttrModels))
 	for i, ra := range replyAttrModels {
-		replyAttrs[i] = v20250101.ReplyAttribute{
+		replyAttrs[i] = v20260501.ReplyAttribute{
 			Name:                 ra.Name.ValueString(),
 			Value:                ra.Value.ValueStringPointer(),
 			ValueFromCertificate: ra.ValueFromCertificate.ValueStringPointer(),
 		}
 	}
 
-	return v20250101.ManagedRadius{
+	return v20260501.ManagedRadius{
 		Id:              model.ID.ValueStringPointer(),
 		Name:            model.Name.ValueString(),
 		NasIPs:          nasIPs,
@@ -66,7 +73,7 @@ func (model *ManagedRadiusModel) ToAPI(ctx context.Context, diags *diag.Diagnost
 	}
 }
 
-func fromAPI(ctx context.Context, diags *diag.Diagnostics, radius *v20250101.ManagedRadius, state utils.AttributeGetter) ManagedRadiusModel {
+func fromAPI(ctx context.Context, diags *diag.Diagnostics, radius *v20260501.ManagedRadius, state utils.AttributeGetter) ManagedRadiusModel {
 	nasIPs, ds := types.ListValueFrom(ctx, types.StringType, radius.NasIPs)
 	diags.Append(ds...)
 
@@ -0,0 +1,37 @@
+package managed_radius
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+
+	utils.RoundTrip[v20260501.ManagedRadius]{
+		Version: "2026-05-01",
+		Schema:  "managedRadius",
+		Convert: func(t *testing.T, radius *v20260501.ManagedRadius) any {
+			var diags diag.Diagnostics
+			model := fromAPI(ctx, &diags, radius, state)
+			got := model.ToAPI(ctx, &diags)
+			require.False(t, diags.HasError(), diags)
+			return got
+		},
+		Want: func(t *testing.T, radius *v20260501.ManagedRadius) any {
+			// The server properties are read-only.
+			radius.ServerCA = nil
+			radius.ServerHostname = nil
+			radius.ServerIP = nil
+			radius.ServerPort = nil
+			radius.Secret = nil
+			return radius
+		},
+	}.Run(t)
+}
@@ -9,12 +9,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
@@ -24,11 +27,11 @@ func NewResource() resource.Resource {
 }
 
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	radius, props, err := utils.Describe("managedRadius")
+	radius, props, err := utils.DescribeV20260501("managedRadius")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Managed RADIUS Schema",
@@ -37,7 +40,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	replyAttrs, replyAttrsProps, err := utils.Describe("replyAttribute")
+	replyAttrs, replyAttrsProps, err := utils.DescribeV20260501("replyAttribute")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Reply Attributes Schema",
@@ -69,6 +72,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"client_ca": schema.StringAttribute{
 				MarkdownDescription: props["clientCA"],
 				Required:            true,
+				Validators: []validator.String{
+					validators.PEMCertificates(),
+				},
 			},
 			"reply_attributes": schema.ListNestedAttribute{
 				MarkdownDescription: replyAttrs,
@@ -119,6 +125,12 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent terraform from deleting or replacing the managed RADIUS server. Set to `false` and apply before destroying or replacing it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -144,13 +156,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, name, "read")
+
 	var id string
 	diags := req.State.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
+	var deletionProtection types.Bool
+	diags = req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -162,7 +179,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	httpResp, err := r.client.GetManagedRadius(ctx, id, &v20250101.GetManagedRadiusParams{})
+	httpResp, err := r.client.GetManagedRadius(ctx, id, &v20260501.GetManagedRadiusParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -185,7 +202,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	radius := &v20250101.ManagedRadius{}
+	radius := &v20260501.ManagedRadius{}
 	if err := json.NewDecoder(httpResp.Body).Decode(radius); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -199,11 +216,22 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, remote)...)
+	// Deletion protection is only tracked in state. Imported servers get the
+	// default.
+	if deletionProtection.IsNull() {
+		deletionProtection = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceModel{
+		ManagedRadiusModel: remote,
+		DeletionProtection: deletionProtection,
+	})...)
 }
 
 func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	plan := &ManagedRadiusModel{}
+	ctx = utils.WithRequestID(ctx, name, "create")
+
+	plan := &ResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -214,7 +242,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	httpResp, err := a.client.PostManagedRadius(ctx, &v20250101.PostManagedRadiusParams{}, reqBody)
+	httpResp, err := a.client.PostManagedRadius(ctx, &v20260501.PostManagedRadiusParams{}, reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -233,7 +261,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	radius := &v20250101.ManagedRadius{}
+	radius := &v20260501.ManagedRadius{}
 	if err := json.NewDecoder(httpResp.Body).Decode(radius); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -246,12 +274,17 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags := resp.State.Set(ctx, model)
+	diags := resp.State.Set(ctx, &ResourceModel{
+		ManagedRadiusModel: model,
+		DeletionProtection: plan.DeletionProtection,
+	})
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	plan := &ManagedRadiusModel{}
+	ctx = utils.WithRequestID(ctx, name, "update")
+
+	plan := &ResourceModel{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -265,7 +298,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PutManagedRadius(ctx, id, &v20250101.PutManagedRadiusParams{}, reqBody)
+	httpResp, err := r.client.PutManagedRadius(ctx, id, &v20260501.PutManagedRadiusParams{}, reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -284,7 +317,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	radius := &v20250101.ManagedRadius{}
+	radius := &v20260501.ManagedRadius{}
 	if err := json.NewDecoder(httpResp.Body).Decode(radius); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -295,11 +328,16 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 
 	model := fromAPI(ctx, &resp.Diagnostics, radius, req.Plan)
 
-	diags = resp.State.Set(ctx, model)
+	diags = resp.State.Set(ctx, &ResourceModel{
+		ManagedRadiusModel: model,
+		DeletionProtection: plan.DeletionProtection,
+	})
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, name, "delete")
+
 	var id string
 	diags := req.State.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
@@ -314,7 +352,21 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.DeleteManagedRadius(ctx, id, &v20250101.DeleteManagedRadiusParams{})
+	var deletionProtection types.Bool
+	diags = req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if deletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Smallstep managed RADIUS is protected from deletion",
+			fmt.Sprintf("Managed RADIUS %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying or replacing it.", id),
+		)
+		return
+	}
+
+	httpResp, err := r.client.DeleteManagedRadius(ctx, id, &v20260501.DeleteManagedRadiusParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -10,8 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -24,7 +24,7 @@ func NewSecretDataSource() datasource.DataSource {
 // By putting the secret in a separate data source users must opt-in to storing
 // the secret in state.
 type SecretDataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (a *SecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,7 +48,7 @@ func (ds *SecretDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	ds.client = clients.V20250101
+	ds.client = clients.Pinned
 }
 
 func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -70,6 +70,8 @@ func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 }
 
 func (ds *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data.smallstep_managed_radius_secret", "read")
+
 	var id string
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
 	resp.Diagnostics.Append(diags...)
@@ -77,7 +79,7 @@ func (ds *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	httpResp, err := ds.client.GetManagedRadius(ctx, id, &v20250101.GetManagedRadiusParams{Secret: utils.Ref(true)})
+	httpResp, err := ds.client.GetManagedRadius(ctx, id, &v20260501.GetManagedRadiusParams{Secret: utils.Ref(true)})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -100,7 +102,7 @@ func (ds *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	managedRadius := &v20250101.ManagedRadius{}
+	managedRadius := &v20260501.ManagedRadius{}
 	if err := json.NewDecoder(httpResp.Body).Decode(managedRadius); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
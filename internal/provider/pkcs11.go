@@ -0,0 +1,7 @@
+//go:build cgo && !nopkcs11
+
+package provider
+
+// pkcs11Supported reports whether pkcs11: private key URIs work. The PKCS #11
+// key manager is only built with cgo.
+const pkcs11Supported = true
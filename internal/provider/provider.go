@@ -1,20 +1,29 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
-	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/api_object"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/authority"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/browser"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/credential"
@@ -41,15 +50,33 @@ type SmallstepProvider struct {
 }
 
 type ClientCertificateModel struct {
-	Certificate types.String `tfsdk:"certificate"`
-	PrivateKey  types.String `tfsdk:"private_key"`
-	TeamID      types.String `tfsdk:"team_id"`
+	Certificate        types.String `tfsdk:"certificate"`
+	CertificateFile    types.String `tfsdk:"certificate_file"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	PrivateKeyFile     types.String `tfsdk:"private_key_file"`
+	PrivateKeyPassword types.String `tfsdk:"private_key_password"`
+	PrivateKeyURI      types.String `tfsdk:"private_key_uri"`
+	PKCS12File         types.String `tfsdk:"pkcs12_file"`
+	PKCS12Password     types.String `tfsdk:"pkcs12_password"`
+	TeamID             types.String `tfsdk:"team_id"`
 }
 
 // SmallstepProviderModel describes the provider data model.
 type SmallstepProviderModel struct {
+	APIURL            types.String            `tfsdk:"api_url"`
+	APIVersion        types.String            `tfsdk:"api_version"`
+	TeamSlug          types.String            `tfsdk:"team_slug"`
 	BearerToken       types.String            `tfsdk:"bearer_token"`
 	ClientCertificate *ClientCertificateModel `tfsdk:"client_certificate"`
+	MaxRetries        types.Int64             `tfsdk:"max_retries"`
+	RetryMaxWait      types.String            `tfsdk:"retry_max_wait"`
+	RequestsPerSecond types.Float64           `tfsdk:"requests_per_second"`
+	MaxConcurrent     types.Int64             `tfsdk:"max_concurrent_requests"`
+	CABundle          types.String            `tfsdk:"ca_bundle"`
+	CABundleFile      types.String            `tfsdk:"ca_bundle_file"`
+	HTTPProxy         types.String            `tfsdk:"http_proxy"`
+	InsecureSkipTLS   types.Bool              `tfsdk:"insecure_skip_verify"`
+	StepContext       types.String            `tfsdk:"step_context"`
 }
 
 func (p *SmallstepProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -60,26 +87,147 @@ func (p *SmallstepProvider) Metadata(ctx context.Context, req provider.MetadataR
 func (p *SmallstepProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the Smallstep API. May also be provided via the SMALLSTEP_API_URL environment variable. Defaults to `https://gateway.smallstep.com/api`.",
+				Optional:            true,
+			},
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Smallstep API used by the resources and data sources every version serves: authorities, provisioners, webhooks, devices, credentials, managed RADIUS and Wi-Fi, VPN, ethernet and browser configurations. Either `2025-01-01` or `2026-05-01`. May also be provided via the SMALLSTEP_API_VERSION environment variable. Defaults to `2025-01-01`. Resources only the newer API serves always use `2026-05-01`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientset.Version20250101, clientset.Version20260501),
+				},
+			},
+			"team_slug": schema.StringAttribute{
+				MarkdownDescription: "Your team's slug. Used to get an API token with a client certificate when `client_certificate.team_id` is not set.",
+				Optional:            true,
+			},
 			"bearer_token": schema.StringAttribute{
 				MarkdownDescription: "Credential used to authenticate to the Smallstep API. May also be provided via the SMALLSTEP_API_TOKEN environment variable. Use the Smallstep dashboard to manage API tokens. Ignored if a client certificate is set.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"step_context": schema.StringAttribute{
+				MarkdownDescription: "The name of a step CLI context to read the API URL, team and client certificate from when they are not set on the provider. May also be provided via the SMALLSTEP_CONTEXT environment variable. The context is read from `contexts.json` in the STEPPATH directory, `~/.step` by default, and its `api-url`, `team`, `team-id`, `x5c-cert` and `x5c-key` defaults are used. The context's client certificate is ignored if `client_certificate`, `bearer_token` or the SMALLSTEP_API_TOKEN environment variable is set.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times a request is retried after a rate limit (429), server error (5xx) or network error. Only idempotent requests are retried after server and network errors. Set to 0 to disable retries. Defaults to 3.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait between retries, as a duration such as `30s`. A longer Retry-After from the API is capped to this value. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum rate of requests sent to the Smallstep API by this provider, across all resources and data sources. Short bursts of up to one second's worth of requests are allowed. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests to the Smallstep API this provider has in flight at once, regardless of terraform's `-parallelism`. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificates trusted in addition to the system roots when connecting to the Smallstep API, e.g. the root of an inspecting proxy.",
+				Optional:            true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file with PEM encoded certificates trusted in addition to the system roots when connecting to the Smallstep API.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used for all requests to the Smallstep API, e.g. `http://proxy.example.com:3128`. Defaults to the proxy set in the HTTPS_PROXY and NO_PROXY environment variables.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the Smallstep API's TLS certificate. Only use this for local stand-ins of the API.",
+				Optional:            true,
+			},
 			"client_certificate": schema.SingleNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "Get an API token with a client certificate key pair signed by your trusted root. Use the Smallstep dashboard to manage trusted roots.",
 				Attributes: map[string]schema.Attribute{
 					"certificate": schema.StringAttribute{
-						MarkdownDescription: "The PEM encoded certificate signed by your trusted root.",
-						Required:            true,
+						MarkdownDescription: "The PEM encoded certificate signed by your trusted root. May include intermediates.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("certificate_file"),
+								path.MatchRelative().AtParent().AtName("pkcs12_file"),
+							),
+						},
+					},
+					"certificate_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a file with the PEM encoded certificate signed by your trusted root.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("pkcs12_file"),
+							),
+						},
 					},
 					"private_key": schema.StringAttribute{
 						MarkdownDescription: "The PEM encoded private key",
-						Required:            true,
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("private_key_file"),
+								path.MatchRelative().AtParent().AtName("pkcs12_file"),
+							),
+						},
+					},
+					"private_key_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a file with the PEM encoded private key.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("pkcs12_file"),
+							),
+						},
+					},
+					"private_key_password": schema.StringAttribute{
+						MarkdownDescription: "The password used to decrypt an encrypted private key. Used as the PIN when `private_key_uri` is a `pkcs11:` URI.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"private_key_uri": schema.StringAttribute{
+						MarkdownDescription: "A KMS URI for a private key that never leaves its key store, e.g. `awskms:key-id=...` or `softkms:path=client.key`. `pkcs11:` URIs for tokens such as a YubiKey require a provider built with cgo; the released binaries are built without it.",
+						Optional:            true,
+						Validators: []validator.String{
+							privateKeyURIValidator{},
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("private_key"),
+								path.MatchRelative().AtParent().AtName("private_key_file"),
+								path.MatchRelative().AtParent().AtName("pkcs12_file"),
+							),
+						},
+					},
+					"pkcs12_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a PKCS#12 file with the certificate, intermediates and private key.",
+						Optional:            true,
+					},
+					"pkcs12_password": schema.StringAttribute{
+						MarkdownDescription: "The password used to decrypt the PKCS#12 file.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRelative().AtParent().AtName("pkcs12_file"),
+							),
+						},
 					},
 					"team_id": schema.StringAttribute{
-						MarkdownDescription: "Your team's UUID",
-						Required:            true,
+						MarkdownDescription: "Your team's UUID. Required unless `team_slug` is set.",
+						Optional:            true,
 					},
 				},
 			},
@@ -96,42 +244,147 @@ func (p *SmallstepProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	if data.APIURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Unknown Smallstep API URL",
+			"The provider cannot connect to the Smallstep API since the api_url is unknown",
+		)
+		return
+	}
+
+	if data.APIVersion.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_version"),
+			"Unknown Smallstep API version",
+			"The provider cannot connect to the Smallstep API since the api_version is unknown",
+		)
+		return
+	}
+
+	apiVersion := cmp.Or(os.Getenv("SMALLSTEP_API_VERSION"), clientset.Version20250101)
+	if !data.APIVersion.IsNull() {
+		apiVersion = data.APIVersion.ValueString()
+	}
+	if apiVersion != clientset.Version20250101 && apiVersion != clientset.Version20260501 {
+		resp.Diagnostics.AddError(
+			"Invalid Smallstep API version",
+			fmt.Sprintf("SMALLSTEP_API_VERSION must be %s or %s, got %q", clientset.Version20250101, clientset.Version20260501, apiVersion),
+		)
+		return
+	}
+
+	if data.StepContext.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("step_context"),
+			"Unknown Smallstep step_context",
+			"The provider cannot connect to the Smallstep API since the step_context is unknown",
+		)
+		return
+	}
+
+	var stepCtx *stepContext
+	contextName := os.Getenv("SMALLSTEP_CONTEXT")
+	if !data.StepContext.IsNull() {
+		contextName = data.StepContext.ValueString()
+	}
+	if contextName != "" {
+		base, err := stepPath()
+		if err == nil {
+			stepCtx, err = loadStepContext(base, contextName)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("step_context"),
+				"Load step context",
+				err.Error(),
+			)
+			return
+		}
+		stepCtx.apply(&data)
+	}
+
+	cfg, diags := data.transportConfig()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg.userAgent = fmt.Sprintf("terraform-provider-smallstep/%s terraform/%s", p.version, req.TerraformVersion)
+
 	server := os.Getenv("SMALLSTEP_API_URL")
+	if !data.APIURL.IsNull() {
+		server = data.APIURL.ValueString()
+	}
+	if server == "" && stepCtx != nil {
+		server = stepCtx.APIURL
+	}
 	if server == "" {
 		server = "https://gateway.smallstep.com/api"
 	}
 
 	if data.ClientCertificate != nil {
-		if data.ClientCertificate.Certificate.IsUnknown() {
+		for name, v := range map[string]types.String{
+			"certificate":          data.ClientCertificate.Certificate,
+			"certificate_file":     data.ClientCertificate.CertificateFile,
+			"private_key":          data.ClientCertificate.PrivateKey,
+			"private_key_file":     data.ClientCertificate.PrivateKeyFile,
+			"private_key_password": data.ClientCertificate.PrivateKeyPassword,
+			"private_key_uri":      data.ClientCertificate.PrivateKeyURI,
+			"pkcs12_file":          data.ClientCertificate.PKCS12File,
+			"pkcs12_password":      data.ClientCertificate.PKCS12Password,
+		} {
+			if v.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("client_certificate").AtName(name),
+					"Unknown Smallstep client certificate",
+					fmt.Sprintf("The provider cannot connect to the Smallstep API since the client_certificate %s is unknown", name),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.ClientCertificate.TeamID.IsUnknown() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("client_certificate.certificate"),
+				path.Root("client_certificate.team_id"),
 				"Unknown Smallstep client certificate",
-				"The provider cannot connect to the Smallstep API since the client_certificate certificate is unknown",
+				"The provider cannot connect to the Smallstep API with a client certificate since the team ID is unknown",
 			)
 			return
 		}
-		if data.ClientCertificate.PrivateKey.IsUnknown() {
+		if data.TeamSlug.IsUnknown() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("client_certificate.private_key"),
-				"Unknown Smallstep client certificate private key",
-				"The provider cannot connect to the Smallstep API since the client_certificate private key is unknown",
+				path.Root("team_slug"),
+				"Unknown Smallstep team slug",
+				"The provider cannot connect to the Smallstep API with a client certificate since the team slug is unknown",
 			)
 			return
 		}
-		if data.BearerToken.IsUnknown() {
+		if data.ClientCertificate.TeamID.IsNull() && data.TeamSlug.IsNull() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("client_certificate.team_id"),
-				"Unknown Smallstep client certificate",
-				"The provider cannot connect to the Smallstep API with a client certificate since the team ID is unknown",
+				"Missing Smallstep team",
+				"The provider cannot connect to the Smallstep API with a client certificate unless either client_certificate.team_id or team_slug is set",
+			)
+			return
+		}
+		clientCert, err := loadClientCertificate(ctx, data.ClientCertificate)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_certificate"),
+				"Load Smallstep client certificate",
+				err.Error(),
 			)
 			return
 		}
 		clients, err := apiClientWithClientCert(
 			ctx,
 			server,
+			apiVersion,
 			data.ClientCertificate.TeamID.ValueString(),
-			data.ClientCertificate.Certificate.ValueString(),
-			data.ClientCertificate.PrivateKey.ValueString(),
+			data.TeamSlug.ValueString(),
+			clientCert,
+			cfg,
 		)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -159,33 +412,141 @@ func (p *SmallstepProvider) Configure(ctx context.Context, req provider.Configur
 		token = data.BearerToken.ValueString()
 	}
 
-	client20250101, err := v20250101.NewClient(server, v20250101.WithRequestEditorFn(v20250101.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
-		r.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
-		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-		return nil
-	})))
+	clients, err := newClientset(server, apiVersion, &http.Client{
+		Transport: &tokenTransport{
+			source: staticTokenSource(token),
+			base:   cfg.wrap(cfg.newBaseTransport()),
+		},
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create Smallstep API client (2025-01-01)", err.Error())
+		resp.Diagnostics.AddError("Failed to create Smallstep API client", err.Error())
 		return
 	}
 
-	client20260501, err := v20260501.NewClient(server, v20260501.WithRequestEditorFn(v20260501.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
-		r.Header.Set("X-Smallstep-Api-Version", "2026-05-01")
-		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-		return nil
-	})))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to create Smallstep API client (2026-05-01)", err.Error())
-		return
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
+}
+
+// transportConfig reads the HTTP settings from the provider configuration.
+func (data *SmallstepProviderModel) transportConfig() (transportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	cfg := defaultTransportConfig()
+
+	if data.MaxRetries.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Smallstep max_retries",
+			"The provider cannot connect to the Smallstep API since max_retries is unknown",
+		)
+	} else if !data.MaxRetries.IsNull() {
+		cfg.maxRetries = int(data.MaxRetries.ValueInt64())
 	}
 
-	clients := &clientset.Clients{
-		V20250101: client20250101,
-		V20260501: client20260501,
+	if data.RetryMaxWait.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown Smallstep retry_max_wait",
+			"The provider cannot connect to the Smallstep API since retry_max_wait is unknown",
+		)
+	} else if !data.RetryMaxWait.IsNull() {
+		d, err := time.ParseDuration(data.RetryMaxWait.ValueString())
+		if err != nil || d <= 0 {
+			diags.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Smallstep retry_max_wait",
+				fmt.Sprintf("retry_max_wait must be a positive duration such as 30s, got %q", data.RetryMaxWait.ValueString()),
+			)
+		} else {
+			cfg.retryMaxWait = d
+		}
 	}
 
-	resp.DataSourceData = clients
-	resp.ResourceData = clients
+	if data.RequestsPerSecond.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Smallstep requests_per_second",
+			"The provider cannot connect to the Smallstep API since requests_per_second is unknown",
+		)
+	} else {
+		cfg = cfg.withRateLimit(data.RequestsPerSecond.ValueFloat64())
+	}
+
+	if data.MaxConcurrent.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown Smallstep max_concurrent_requests",
+			"The provider cannot connect to the Smallstep API since max_concurrent_requests is unknown",
+		)
+	} else {
+		cfg = cfg.withMaxConcurrentRequests(int(data.MaxConcurrent.ValueInt64()))
+	}
+
+	for name, v := range map[string]attr.Value{
+		"ca_bundle":            data.CABundle,
+		"ca_bundle_file":       data.CABundleFile,
+		"http_proxy":           data.HTTPProxy,
+		"insecure_skip_verify": data.InsecureSkipTLS,
+	} {
+		if v.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Unknown Smallstep "+name,
+				fmt.Sprintf("The provider cannot connect to the Smallstep API since %s is unknown", name),
+			)
+		}
+	}
+	if diags.HasError() {
+		return cfg, diags
+	}
+
+	if !data.CABundle.IsNull() || !data.CABundleFile.IsNull() {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !data.CABundle.IsNull() && !pool.AppendCertsFromPEM([]byte(data.CABundle.ValueString())) {
+			diags.AddAttributeError(
+				path.Root("ca_bundle"),
+				"Invalid Smallstep ca_bundle",
+				"ca_bundle does not contain any PEM encoded certificates",
+			)
+		}
+		if !data.CABundleFile.IsNull() {
+			b, err := os.ReadFile(data.CABundleFile.ValueString())
+			switch {
+			case err != nil:
+				diags.AddAttributeError(
+					path.Root("ca_bundle_file"),
+					"Invalid Smallstep ca_bundle_file",
+					err.Error(),
+				)
+			case !pool.AppendCertsFromPEM(b):
+				diags.AddAttributeError(
+					path.Root("ca_bundle_file"),
+					"Invalid Smallstep ca_bundle_file",
+					fmt.Sprintf("%s does not contain any PEM encoded certificates", data.CABundleFile.ValueString()),
+				)
+			}
+		}
+		cfg.rootCAs = pool
+	}
+
+	if !data.HTTPProxy.IsNull() {
+		u, err := url.Parse(data.HTTPProxy.ValueString())
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			diags.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid Smallstep http_proxy",
+				fmt.Sprintf("http_proxy must be a URL such as http://proxy.example.com:3128, got %q", data.HTTPProxy.ValueString()),
+			)
+		} else {
+			cfg.proxyURL = u
+		}
+	}
+
+	cfg.insecureSkipVerify = data.InsecureSkipTLS.ValueBool()
+
+	return cfg, diags
 }
 
 func (p *SmallstepProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -197,12 +558,14 @@ func (p *SmallstepProvider) Resources(ctx context.Context) []func() resource.Res
 		managed_radius.NewResource,
 		identity_provider.NewIdentityProviderResource,
 		identity_provider.NewClientResource,
+		identity_provider.NewSSOIntegrationResource,
 		credential.NewResource,
 		wifi.NewResource,
 		ethernet.NewResource,
 		browser.NewResource,
 		vpn.NewResource,
 		proxy.NewResource,
+		api_object.NewResource,
 	}
 }
 
@@ -0,0 +1,155 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+)
+
+var _ resource.ResourceWithConfigValidators = (*Resource)(nil)
+
+// ConfigValidators checks the attributes that depend on each other. The order
+// of each min, default and max claim duration is checked by the attribute
+// validators.
+func (r *Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		sshClaimsValidator{},
+		typeValidator{},
+	}
+}
+
+// sshClaims are the claims the API only uses when enable_ssh_ca is true.
+var sshClaims = []string{
+	"min_user_ssh_cert_duration",
+	"max_user_ssh_cert_duration",
+	"default_user_ssh_cert_duration",
+	"min_host_ssh_cert_duration",
+	"max_host_ssh_cert_duration",
+	"default_host_ssh_cert_duration",
+}
+
+// sshClaimsValidator checks that SSH certificate durations are only set on
+// provisioners that issue SSH certificates.
+type sshClaimsValidator struct{}
+
+func (v sshClaimsValidator) Description(_ context.Context) string {
+	return "SSH certificate durations require claims.enable_ssh_ca to be true"
+}
+
+func (v sshClaimsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshClaimsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var enabled types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("claims").AtName("enable_ssh_ca"), &enabled)...)
+	if resp.Diagnostics.HasError() || enabled.IsUnknown() || enabled.ValueBool() {
+		return
+	}
+
+	for _, name := range sshClaims {
+		p := path.Root("claims").AtName(name)
+		var duration types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &duration)...)
+		if duration.ValueString() == "" {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Invalid Provisioner Claims",
+			fmt.Sprintf("Attribute %s is only used when claims.enable_ssh_ca is true.", p),
+		)
+	}
+}
+
+// typeBlocks maps each provisioner type to the attribute with its
+// configuration.
+var typeBlocks = map[v20260501.ProvisionerType]string{
+	v20260501.JWK:             "jwk",
+	v20260501.OIDC:            "oidc",
+	v20260501.ACME:            "acme",
+	v20260501.ACMEATTESTATION: "acme_attestation",
+	v20260501.X5C:             "x5c",
+	v20260501.AWS:             "aws",
+	v20260501.GCP:             "gcp",
+	v20260501.AZURE:           "azure",
+}
+
+// typeValidator checks that exactly one type attribute is set and that it
+// matches the provisioner's type.
+type typeValidator struct{}
+
+func (v typeValidator) Description(_ context.Context) string {
+	return "exactly one of the type attributes must be set, matching the provisioner's type"
+}
+
+func (v typeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v typeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var typ types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &typ)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blocks := slices.Sorted(maps.Values(typeBlocks))
+	var set []string
+	for _, block := range blocks {
+		var obj types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block), &obj)...)
+		if obj.IsUnknown() {
+			return
+		}
+		if !obj.IsNull() {
+			set = append(set, block)
+		}
+	}
+
+	if len(set) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(set[1]),
+			"Invalid Provisioner Configuration",
+			fmt.Sprintf("Only one of %s can be set, got %s.", strings.Join(blocks, ", "), strings.Join(set, " and ")),
+		)
+		return
+	}
+
+	if typ.IsUnknown() || typ.IsNull() {
+		return
+	}
+	want, ok := typeBlocks[v20260501.ProvisionerType(typ.ValueString())]
+	switch {
+	case !ok:
+		var supported []string
+		for t := range typeBlocks {
+			supported = append(supported, string(t))
+		}
+		slices.Sort(supported)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Provisioner Type",
+			fmt.Sprintf("Provisioner type %q is not supported. Use one of %s.", typ.ValueString(), strings.Join(supported, ", ")),
+		)
+	case len(set) == 0:
+		resp.Diagnostics.AddAttributeError(
+			path.Root(want),
+			"Missing Provisioner Configuration",
+			fmt.Sprintf("A provisioner of type %s requires the %s attribute.", typ.ValueString(), want),
+		)
+	case set[0] != want:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Provisioner Type",
+			fmt.Sprintf("Provisioner type %s requires the %s attribute, but %s is set.", typ.ValueString(), want, set[0]),
+		)
+	}
+}
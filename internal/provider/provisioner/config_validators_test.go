@@ -0,0 +1,125 @@
+package provisioner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidators(t *testing.T) {
+	ctx := context.Background()
+	r := NewResource().(*Resource)
+
+	jwk := &JWKModel{Key: types.StringValue("{}"), EncryptedKey: types.StringNull()}
+	x5c := &X5CModel{Roots: types.SetNull(types.StringType)}
+	claims := func(enableSSHCA types.Bool, userSSH types.String) *ClaimsModel {
+		return &ClaimsModel{
+			EnableSSHCA:                enableSSHCA,
+			DefaultUserSSHCertDuration: userSSH,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		model   ResourceModel
+		wantErr string
+	}{
+		{
+			name:  "jwk",
+			model: ResourceModel{Model: Model{Type: types.StringValue("JWK"), JWK: jwk}},
+		},
+		{
+			name:  "unknown type",
+			model: ResourceModel{Model: Model{Type: types.StringUnknown(), X5C: x5c}},
+		},
+		{
+			name:    "missing block",
+			model:   ResourceModel{Model: Model{Type: types.StringValue("JWK")}},
+			wantErr: "Missing Provisioner Configuration",
+		},
+		{
+			name:    "two blocks",
+			model:   ResourceModel{Model: Model{Type: types.StringValue("JWK"), JWK: jwk, X5C: x5c}},
+			wantErr: "Invalid Provisioner Configuration",
+		},
+		{
+			name:    "wrong block",
+			model:   ResourceModel{Model: Model{Type: types.StringValue("JWK"), X5C: x5c}},
+			wantErr: "Invalid Provisioner Type",
+		},
+		{
+			name:    "unsupported type",
+			model:   ResourceModel{Model: Model{Type: types.StringValue("SCEP"), JWK: jwk}},
+			wantErr: "Invalid Provisioner Type",
+		},
+		{
+			name: "ssh enabled",
+			model: ResourceModel{Model: Model{
+				Type:   types.StringValue("JWK"),
+				JWK:    jwk,
+				Claims: claims(types.BoolValue(true), types.StringValue("16h")),
+			}},
+		},
+		{
+			name: "ssh unknown",
+			model: ResourceModel{Model: Model{
+				Type:   types.StringValue("JWK"),
+				JWK:    jwk,
+				Claims: claims(types.BoolUnknown(), types.StringValue("16h")),
+			}},
+		},
+		{
+			name: "ssh disabled and empty",
+			model: ResourceModel{Model: Model{
+				Type:   types.StringValue("JWK"),
+				JWK:    jwk,
+				Claims: claims(types.BoolValue(false), types.StringValue("")),
+			}},
+		},
+		{
+			name: "ssh disabled",
+			model: ResourceModel{Model: Model{
+				Type:   types.StringValue("JWK"),
+				JWK:    jwk,
+				Claims: claims(types.BoolValue(false), types.StringValue("16h")),
+			}},
+			wantErr: "Invalid Provisioner Claims",
+		},
+		{
+			name: "ssh not set",
+			model: ResourceModel{Model: Model{
+				Type:   types.StringValue("JWK"),
+				JWK:    jwk,
+				Claims: claims(types.BoolNull(), types.StringValue("16h")),
+			}},
+			wantErr: "Invalid Provisioner Claims",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := utils.NullState(t, r)
+			require.False(t, state.Set(ctx, tc.model).HasError())
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			for _, v := range r.ConfigValidators(ctx) {
+				v.ValidateResource(ctx, req, resp)
+			}
+
+			if tc.wantErr == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1, resp.Diagnostics)
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
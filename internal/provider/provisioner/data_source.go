@@ -10,8 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -23,7 +23,7 @@ func NewDataSource() datasource.DataSource {
 
 // DataSource implements data.smallstep_provisioner
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (a *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,10 +47,12 @@ func (a *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	a.client = clients.V20250101
+	a.client = clients.Pinned
 }
 
 func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+provisionerTypeName, "read")
+
 	var config Model
 
 	// Read Terraform configuration data into the model
@@ -64,7 +66,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	if nameOrID == "" {
 		nameOrID = config.Name.ValueString()
 	}
-	httpResp, err := a.client.GetProvisioner(ctx, config.AuthorityID.ValueString(), nameOrID, &v20250101.GetProvisionerParams{})
+	httpResp, err := a.client.GetProvisioner(ctx, config.AuthorityID.ValueString(), nameOrID, &v20260501.GetProvisionerParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -83,7 +85,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	provisioner := &v20250101.Provisioner{}
+	provisioner := &v20260501.Provisioner{}
 	if err := json.NewDecoder(httpResp.Body).Decode(provisioner); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -102,7 +104,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 }
 
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	prov, provProps, err := utils.Describe("provisioner")
+	prov, provProps, err := utils.DescribeV20260501("provisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -111,7 +113,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 		return
 	}
 
-	options, _, err := utils.Describe("provisionerOptions")
+	options, _, err := utils.DescribeV20260501("provisionerOptions")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -120,7 +122,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 		return
 	}
 
-	x509, x509Props, err := utils.Describe("x509Options")
+	x509, x509Props, err := utils.DescribeV20260501("x509Options")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -129,7 +131,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 		return
 	}
 
-	ssh, sshProps, err := utils.Describe("sshOptions")
+	ssh, sshProps, err := utils.DescribeV20260501("sshOptions")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -138,7 +140,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 		return
 	}
 
-	claims, claimsProps, err := utils.Describe("provisionerClaims")
+	claims, claimsProps, err := utils.DescribeV20260501("provisionerClaims")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -147,7 +149,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 		return
 	}
 
-	jwk, jwkProps, err := utils.Describe("jwkProvisioner")
+	jwk, jwkProps, err := utils.DescribeV20260501("jwkProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -157,7 +159,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 	}
 	jwk += " This object is populated when type is `JWK`."
 
-	oidc, oidcProps, err := utils.Describe("oidcProvisioner")
+	oidc, oidcProps, err := utils.DescribeV20260501("oidcProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -167,7 +169,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 	}
 	oidc += " This object is populated when type is `OIDC`."
 
-	acme, acmeProps, err := utils.Describe("acmeProvisioner")
+	acme, acmeProps, err := utils.DescribeV20260501("acmeProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -177,7 +179,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 	}
 	acme += " This object is populated when type is `ACME`."
 
-	attest, attestProps, err := utils.Describe("acmeAttestationProvisioner")
+	attest, attestProps, err := utils.DescribeV20260501("acmeAttestationProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -187,7 +189,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 	}
 	attest += " This object is populated when type is `ACME_ATTESTATION`."
 
-	x5c, x5cProps, err := utils.Describe("x5cProvisioner")
+	x5c, x5cProps, err := utils.DescribeV20260501("x5cProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -197,7 +199,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 	}
 	x5c += " This object is populated when type is `X5C`."
 
-	aws, awsProps, err := utils.Describe("awsProvisioner")
+	aws, awsProps, err := utils.DescribeV20260501("awsProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -207,7 +209,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 	}
 	aws += " This object is populated when type is `AWS`."
 
-	gcp, gcpProps, err := utils.Describe("gcpProvisioner")
+	gcp, gcpProps, err := utils.DescribeV20260501("gcpProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -217,7 +219,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 	}
 	gcp += " This object is populated when type is `GCP`."
 
-	azure, azureProps, err := utils.Describe("azureProvisioner")
+	azure, azureProps, err := utils.DescribeV20260501("azureProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
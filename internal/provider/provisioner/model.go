@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 	"go.step.sm/crypto/jose"
 )
@@ -36,6 +36,13 @@ type Model struct {
 	Azure           *AzureModel           `tfsdk:"azure"`
 }
 
+// ResourceModel adds the attributes of the provisioner resource that are only
+// tracked in state to the model shared with the data source.
+type ResourceModel struct {
+	Model
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 type OptionsModel struct {
 	X509 *TemplateModel `tfsdk:"x509"`
 	SSH  *TemplateModel `tfsdk:"ssh"`
@@ -148,14 +155,14 @@ type AzureModel struct {
 	DisableTrustOnFirstUse types.Bool   `tfsdk:"disable_trust_on_first_use"`
 }
 
-func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
-	p := &v20250101.Provisioner{
+func toAPI(ctx context.Context, m *Model) (*v20260501.Provisioner, error) {
+	p := &v20260501.Provisioner{
 		Id:   m.ID.ValueStringPointer(),
 		Name: m.Name.ValueString(),
-		Type: v20250101.ProvisionerType(m.Type.ValueString()),
+		Type: v20260501.ProvisionerType(m.Type.ValueString()),
 	}
 	if m.Claims != nil {
-		p.Claims = &v20250101.ProvisionerClaims{
+		p.Claims = &v20260501.ProvisionerClaims{
 			DisableRenewal:             m.Claims.DisableRenewal.ValueBoolPointer(),
 			AllowRenewalAfterExpiry:    m.Claims.AllowRenewalAfterExpiry.ValueBoolPointer(),
 			EnableSSHCA:                m.Claims.EnableSSHCA.ValueBoolPointer(),
@@ -172,9 +179,9 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 	}
 
 	if m.Options != nil {
-		p.Options = &v20250101.ProvisionerOptions{}
+		p.Options = &v20260501.ProvisionerOptions{}
 		if m.Options.X509 != nil {
-			p.Options.X509 = &v20250101.X509Options{
+			p.Options.X509 = &v20260501.X509Options{
 				Template: m.Options.X509.Template.ValueStringPointer(),
 			}
 			if !m.Options.X509.TemplateData.IsNull() {
@@ -187,7 +194,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			}
 		}
 		if m.Options.SSH != nil {
-			p.Options.Ssh = &v20250101.SshOptions{
+			p.Options.Ssh = &v20260501.SshOptions{
 				Template: m.Options.SSH.Template.ValueStringPointer(),
 			}
 			if !m.Options.SSH.TemplateData.IsNull() {
@@ -204,7 +211,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 	switch {
 	case m.JWK != nil:
 		ek := m.JWK.EncryptedKey.ValueString()
-		jwk := v20250101.JwkProvisioner{
+		jwk := v20260501.JwkProvisioner{
 			Key:          map[string]any{},
 			EncryptedKey: &ek,
 		}
@@ -223,7 +230,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			return nil, err
 		}
 	case m.OIDC != nil:
-		oidc := v20250101.OidcProvisioner{
+		oidc := v20260501.OidcProvisioner{
 			ClientID:              m.OIDC.ClientID.ValueString(),
 			ClientSecret:          m.OIDC.ClientSecret.ValueString(),
 			ConfigurationEndpoint: m.OIDC.ConfigurationEndpoint.ValueString(),
@@ -256,7 +263,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			return nil, err
 		}
 	case m.ACME != nil:
-		acme := v20250101.AcmeProvisioner{
+		acme := v20260501.AcmeProvisioner{
 			ForceCN:    m.ACME.ForceCN.ValueBoolPointer(),
 			RequireEAB: m.ACME.RequireEAB.ValueBool(),
 		}
@@ -270,7 +277,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			return nil, err
 		}
 	case m.ACMEAttestation != nil:
-		attest := v20250101.AcmeAttestationProvisioner{
+		attest := v20260501.AcmeAttestationProvisioner{
 			ForceCN:    m.ACMEAttestation.ForceCN.ValueBoolPointer(),
 			RequireEAB: m.ACMEAttestation.RequireEAB.ValueBoolPointer(),
 		}
@@ -291,7 +298,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			return nil, err
 		}
 	case m.X5C != nil:
-		x5c := v20250101.X5cProvisioner{}
+		x5c := v20260501.X5cProvisioner{}
 		diagnostics := m.X5C.Roots.ElementsAs(ctx, &x5c.Roots, false)
 		if err := utils.DiagnosticsToErr(diagnostics); err != nil {
 			return nil, err
@@ -301,7 +308,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			return nil, err
 		}
 	case m.AWS != nil:
-		aws := v20250101.AwsProvisioner{
+		aws := v20260501.AwsProvisioner{
 			DisableTrustOnFirstUse: m.AWS.DisableTrustOnFirstUse.ValueBoolPointer(),
 			DisableCustomSANs:      m.AWS.DisableCustomSANs.ValueBoolPointer(),
 			InstanceAge:            m.AWS.InstanceAge.ValueStringPointer(),
@@ -315,7 +322,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			return nil, err
 		}
 	case m.GCP != nil:
-		gcp := v20250101.GcpProvisioner{
+		gcp := v20260501.GcpProvisioner{
 			DisableTrustOnFirstUse: m.GCP.DisableTrustOnFirstUse.ValueBoolPointer(),
 			DisableCustomSANs:      m.GCP.DisableCustomSANs.ValueBoolPointer(),
 			InstanceAge:            m.GCP.InstanceAge.ValueStringPointer(),
@@ -335,7 +342,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 			return nil, err
 		}
 	case m.Azure != nil:
-		azure := v20250101.AzureProvisioner{
+		azure := v20260501.AzureProvisioner{
 			TenantID:               m.Azure.TenantID.ValueString(),
 			Audience:               m.Azure.Audience.ValueStringPointer(),
 			DisableTrustOnFirstUse: m.Azure.DisableTrustOnFirstUse.ValueBoolPointer(),
@@ -355,7 +362,7 @@ func toAPI(ctx context.Context, m *Model) (*v20250101.Provisioner, error) {
 	return p, nil
 }
 
-func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityID string, state utils.AttributeGetter) (*Model, diag.Diagnostics) {
+func fromAPI(ctx context.Context, provisioner *v20260501.Provisioner, authorityID string, state utils.AttributeGetter) (*Model, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	data := &Model{
@@ -460,7 +467,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 	}
 
 	switch provisioner.Type {
-	case v20250101.JWK:
+	case v20260501.JWK:
 		jwk, err := provisioner.AsJwkProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -485,7 +492,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 		}
 		data.JWK.EncryptedKey = encryptedKey
 
-	case v20250101.OIDC:
+	case v20260501.OIDC:
 		oidc, err := provisioner.AsOidcProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -536,7 +543,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 		}
 		data.OIDC.TenantID = tenantID
 
-	case v20250101.ACME:
+	case v20260501.ACME:
 		acme, err := provisioner.AsAcmeProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -560,7 +567,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 		}
 		data.ACME.Challenges = challengesSet
 
-	case v20250101.ACMEATTESTATION:
+	case v20260501.ACMEATTESTATION:
 		attest, err := provisioner.AsAcmeAttestationProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -604,7 +611,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 		}
 		data.ACMEAttestation.AttestationRoots = attestationRoots
 
-	case v20250101.X5C:
+	case v20260501.X5C:
 		x5c, err := provisioner.AsX5cProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -626,7 +633,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 			Roots: rootsSet,
 		}
 
-	case v20250101.AWS:
+	case v20260501.AWS:
 		aws, err := provisioner.AsAwsProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -667,7 +674,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 			DisableCustomSANs:      disableCustomSANs,
 		}
 
-	case v20250101.GCP:
+	case v20260501.GCP:
 		gcp, err := provisioner.AsGcpProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -710,7 +717,7 @@ func fromAPI(ctx context.Context, provisioner *v20250101.Provisioner, authorityI
 			DisableTrustOnFirstUse: disableTOFU,
 		}
 
-	case v20250101.AZURE:
+	case v20260501.AZURE:
 		azure, err := provisioner.AsAzureProvisioner()
 		if err != nil {
 			diags.AddError(
@@ -0,0 +1,84 @@
+package provisioner
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+	authorityID := "d6a9fb0b-8f5e-4c7e-9a8e-1b0c6f6a0c1e"
+	pubJWK, privJWK := utils.NewJWK(t, "password")
+	var key any
+	require.NoError(t, json.Unmarshal([]byte(pubJWK), &key))
+
+	utils.RoundTrip[v20260501.Provisioner]{
+		Version: "2026-05-01",
+		Schema:  "provisioner",
+		Seeds:   500,
+		Prepare: func(t *testing.T, provisioner *v20260501.Provisioner) bool {
+			// The resource doesn't support SCEP provisioners.
+			if provisioner.Type == v20260501.SCEP {
+				return false
+			}
+			// JWK provisioners need a real key.
+			if provisioner.Type == v20260501.JWK {
+				require.NoError(t, provisioner.FromJwkProvisioner(v20260501.JwkProvisioner{
+					Key:          key,
+					EncryptedKey: &privJWK,
+				}))
+			}
+			return true
+		},
+		Convert: func(t *testing.T, provisioner *v20260501.Provisioner) any {
+			model, diags := fromAPI(ctx, provisioner, authorityID, state)
+			require.False(t, diags.HasError(), diags)
+			got, err := toAPI(ctx, model)
+			require.NoError(t, err)
+			return got
+		},
+		Want: func(t *testing.T, provisioner *v20260501.Provisioner) any {
+			// The creation time is read-only, and webhooks are managed with
+			// the smallstep_provisioner_webhook resource.
+			want := map[string]any{}
+			b, err := json.Marshal(provisioner)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &want))
+			delete(want, "createdAt")
+			if options, ok := want["options"].(map[string]any); ok {
+				delete(options, "webhooks")
+			}
+			return want
+		},
+	}.Run(t)
+}
+
+func TestUseConfiguredDurationIfEqual(t *testing.T) {
+	tf := &ClaimsModel{
+		MinTLSCertDuration:     types.StringValue("5m"),
+		MaxTLSCertDuration:     types.StringValue("24h"),
+		DefaultTLSCertDuration: types.StringValue("1h"),
+		MinUserSSHCertDuration: types.StringValue("5m"),
+	}
+	api := &ClaimsModel{
+		MinTLSCertDuration:     types.StringValue("10m0s"),
+		MaxTLSCertDuration:     types.StringValue("24h0m0s"),
+		DefaultTLSCertDuration: types.StringValue("1h0m0s"),
+		MinUserSSHCertDuration: types.StringNull(),
+	}
+
+	useConfiguredDurationIfEqual(tf, api)
+
+	assert.Equal(t, types.StringValue("10m0s"), api.MinTLSCertDuration, "different durations are not replaced")
+	assert.Equal(t, types.StringValue("24h"), api.MaxTLSCertDuration)
+	assert.Equal(t, types.StringValue("1h"), api.DefaultTLSCertDuration)
+	assert.Equal(t, types.StringNull(), api.MinUserSSHCertDuration)
+}
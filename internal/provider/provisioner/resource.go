@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
@@ -32,7 +35,7 @@ func NewResource() resource.Resource {
 
 // Resource defines the provisioner resource implementation.
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,7 +43,7 @@ func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, r
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	prov, provProps, err := utils.Describe("provisioner")
+	prov, provProps, err := utils.DescribeV20260501("provisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -49,7 +52,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	options, _, err := utils.Describe("provisionerOptions")
+	options, _, err := utils.DescribeV20260501("provisionerOptions")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -58,7 +61,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	x509, x509Props, err := utils.Describe("x509Options")
+	x509, x509Props, err := utils.DescribeV20260501("x509Options")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -67,7 +70,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	ssh, sshProps, err := utils.Describe("sshOptions")
+	ssh, sshProps, err := utils.DescribeV20260501("sshOptions")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -76,7 +79,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	claims, claimsProps, err := utils.Describe("provisionerClaims")
+	claims, claimsProps, err := utils.DescribeV20260501("provisionerClaims")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -85,7 +88,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		return
 	}
 
-	jwk, jwkProps, err := utils.Describe("jwkProvisioner")
+	jwk, jwkProps, err := utils.DescribeV20260501("jwkProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -95,7 +98,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 	jwk += " This object is required when type is `JWK` and is otherwise ignored."
 
-	oidc, oidcProps, err := utils.Describe("oidcProvisioner")
+	oidc, oidcProps, err := utils.DescribeV20260501("oidcProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -105,7 +108,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 	oidc += " This object is required when type is `OIDC` and is otherwise ignored."
 
-	acme, acmeProps, err := utils.Describe("acmeProvisioner")
+	acme, acmeProps, err := utils.DescribeV20260501("acmeProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -115,7 +118,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 	acme += " This object is required when type is `ACME` and is otherwise ignored."
 
-	attest, attestProps, err := utils.Describe("acmeAttestationProvisioner")
+	attest, attestProps, err := utils.DescribeV20260501("acmeAttestationProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -125,7 +128,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 	attest += " This object is required when type is `ACME_ATTESTATION` and is otherwise ignored."
 
-	x5c, x5cProps, err := utils.Describe("x5cProvisioner")
+	x5c, x5cProps, err := utils.DescribeV20260501("x5cProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -135,7 +138,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 	x5c += " This object is required when type is `X5C` and is otherwise ignored."
 
-	aws, awsProps, err := utils.Describe("awsProvisioner")
+	aws, awsProps, err := utils.DescribeV20260501("awsProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -145,7 +148,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 	aws += " This object is required when type is `AWS` and is otherwise ignored."
 
-	gcp, gcpProps, err := utils.Describe("gcpProvisioner")
+	gcp, gcpProps, err := utils.DescribeV20260501("gcpProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -155,7 +158,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 	gcp += " This object is required when type is `GCP` and is otherwise ignored."
 
-	azure, azureProps, err := utils.Describe("azureProvisioner")
+	azure, azureProps, err := utils.DescribeV20260501("azureProvisioner")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI schema",
@@ -166,7 +169,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	azure += " This object is required when type is `AZURE` and is otherwise ignored."
 
 	resp.Schema = schema.Schema{
-		MarkdownDescription: prov,
+		MarkdownDescription: prov + " Every change replaces the provisioner, since the API has no endpoint to update a provisioner in place.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -206,6 +209,12 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent terraform from deleting or replacing the provisioner. Set to `false` and apply before destroying or replacing the provisioner. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"claims": schema.SingleNestedAttribute{
 				MarkdownDescription: claims,
 				Optional:            true,
@@ -234,6 +243,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"min_tls_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["minTLSCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -241,6 +253,10 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"max_tls_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["maxTLSCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+							validators.DurationAtLeast(path.MatchRelative().AtParent().AtName("min_tls_cert_duration")),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -248,6 +264,11 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"default_tls_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["defaultTLSCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+							validators.DurationAtLeast(path.MatchRelative().AtParent().AtName("min_tls_cert_duration")),
+							validators.DurationAtMost(path.MatchRelative().AtParent().AtName("max_tls_cert_duration")),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -255,6 +276,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"min_user_ssh_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["minUserSSHCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -262,6 +286,10 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"max_user_ssh_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["maxUserSSHCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+							validators.DurationAtLeast(path.MatchRelative().AtParent().AtName("min_user_ssh_cert_duration")),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -269,6 +297,11 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"default_user_ssh_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["defaultUserSSHCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+							validators.DurationAtLeast(path.MatchRelative().AtParent().AtName("min_user_ssh_cert_duration")),
+							validators.DurationAtMost(path.MatchRelative().AtParent().AtName("max_user_ssh_cert_duration")),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -276,6 +309,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"min_host_ssh_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["minHostSSHCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -283,6 +319,10 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"max_host_ssh_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["maxHostSSHCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+							validators.DurationAtLeast(path.MatchRelative().AtParent().AtName("min_host_ssh_cert_duration")),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -290,6 +330,11 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"default_host_ssh_cert_duration": schema.StringAttribute{
 						MarkdownDescription: claimsProps["defaultHostSSHCertDuration"],
 						Optional:            true,
+						Validators: []validator.String{
+							validators.Duration(),
+							validators.DurationAtLeast(path.MatchRelative().AtParent().AtName("min_host_ssh_cert_duration")),
+							validators.DurationAtMost(path.MatchRelative().AtParent().AtName("max_host_ssh_cert_duration")),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -349,6 +394,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"key": schema.StringAttribute{
 						MarkdownDescription: jwkProps["key"],
 						Required:            true,
+						Validators: []validator.String{
+							validators.JWKPublicKey(),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -473,6 +521,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 						MarkdownDescription: attestProps["attestationRoots"],
 						ElementType:         types.StringType,
 						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(validators.PEMCertificates()),
+						},
 						PlanModifiers: []planmodifier.Set{
 							setplanmodifier.RequiresReplace(),
 						},
@@ -505,6 +556,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 						MarkdownDescription: x5cProps["roots"],
 						Required:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(validators.PEMCertificates()),
+						},
 						PlanModifiers: []planmodifier.Set{
 							setplanmodifier.RequiresReplace(),
 						},
@@ -650,11 +704,13 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan Model
+	ctx = utils.WithRequestID(ctx, provisionerTypeName, "create")
+
+	var plan ResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -662,7 +718,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	p, err := toAPI(ctx, &plan)
+	p, err := toAPI(ctx, &plan.Model)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client",
@@ -671,10 +727,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	b, _ := json.Marshal(p)
-	tflog.Trace(ctx, string(b))
-
-	httpResp, err := a.client.PostAuthorityProvisioners(ctx, plan.AuthorityID.ValueString(), &v20250101.PostAuthorityProvisionersParams{}, *p)
+	httpResp, err := a.client.PostAuthorityProvisioners(ctx, plan.AuthorityID.ValueString(), &v20260501.PostAuthorityProvisionersParams{}, *p)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -693,7 +746,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	provisioner := &v20250101.Provisioner{}
+	provisioner := &v20260501.Provisioner{}
 	if err := json.NewDecoder(httpResp.Body).Decode(provisioner); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -724,11 +777,16 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	tflog.Trace(ctx, fmt.Sprintf("create provisioner %q resource", plan.ID.ValueString()))
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceModel{
+		Model:              *state,
+		DeletionProtection: plan.DeletionProtection,
+	})...)
 }
 
 func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	state := &Model{}
+	ctx = utils.WithRequestID(ctx, provisionerTypeName, "read")
+
+	state := &ResourceModel{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
 
@@ -740,7 +798,7 @@ func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	if nameOrID == "" {
 		nameOrID = state.Name.ValueString()
 	}
-	httpResp, err := a.client.GetProvisioner(ctx, state.AuthorityID.ValueString(), nameOrID, &v20250101.GetProvisionerParams{})
+	httpResp, err := a.client.GetProvisioner(ctx, state.AuthorityID.ValueString(), nameOrID, &v20260501.GetProvisionerParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -764,7 +822,7 @@ func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	provisioner := &v20250101.Provisioner{}
+	provisioner := &v20260501.Provisioner{}
 	if err := json.NewDecoder(httpResp.Body).Decode(provisioner); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -793,17 +851,42 @@ func (a *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		actual.Claims = nil
 	}
 
+	// Deletion protection is only tracked in state. Imported provisioners get
+	// the default.
+	deletionProtection := state.DeletionProtection
+	if deletionProtection.IsNull() {
+		deletionProtection = types.BoolValue(false)
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("read provisioner %q resource", state.ID.ValueString()))
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, actual)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceModel{
+		Model:              *actual,
+		DeletionProtection: deletionProtection,
+	})...)
 }
 
+// Update only changes deletion_protection since every other attribute
+// requires replacement: neither the 2025-01-01 nor the 2026-05-01 API has an
+// endpoint to update a provisioner, only to create, get and delete one. Once
+// the API can update provisioners, drop RequiresReplace from the mutable
+// attributes (claims, options, OIDC lists and ACME challenges) and update them
+// here.
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Update not supported. All changes require replacement.
+	var deletionProtection types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), deletionProtection)...)
 }
 
 func (a *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state Model
+	ctx = utils.WithRequestID(ctx, provisionerTypeName, "delete")
+
+	var state ResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -811,11 +894,19 @@ func (a *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Smallstep provisioner is protected from deletion",
+			fmt.Sprintf("Provisioner %q has deletion_protection enabled. Set deletion_protection = false and apply before destroying or replacing it.", state.Name.ValueString()),
+		)
+		return
+	}
+
 	nameOrID := state.ID.ValueString()
 	if nameOrID == "" {
 		nameOrID = state.Name.ValueString()
 	}
-	httpResp, err := a.client.DeleteProvisioner(ctx, state.AuthorityID.ValueString(), nameOrID, &v20250101.DeleteProvisionerParams{})
+	httpResp, err := a.client.DeleteProvisioner(ctx, state.AuthorityID.ValueString(), nameOrID, &v20260501.DeleteProvisionerParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -879,7 +970,7 @@ func useConfiguredDurationIfEqual(tf, api *ClaimsModel) {
 		return
 	}
 
-	if durationEqual(tf.MinTLSCertDuration, tf.MinTLSCertDuration) {
+	if durationEqual(tf.MinTLSCertDuration, api.MinTLSCertDuration) {
 		api.MinTLSCertDuration = tf.MinTLSCertDuration
 	}
 	if durationEqual(tf.MaxTLSCertDuration, api.MaxTLSCertDuration) {
@@ -85,6 +85,8 @@ func (ds *DataSource) Configure(ctx context.Context, req datasource.ConfigureReq
 }
 
 func (ds *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+typeName, "read")
+
 	var config *Model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
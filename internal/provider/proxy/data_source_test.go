@@ -11,7 +11,7 @@ import (
 func TestAccProxyDataSource(t *testing.T) {
 	credential := utils.NewCredential(t)
 	credentialID := *credential.Id
-	proxyName := "tfprovider-" + utils.Slug(t)
+	proxyName := utils.Slug(t)
 
 	helper.Test(t, helper.TestCase{
 		ProtoV6ProviderFactories: providerFactories,
@@ -0,0 +1,26 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	utils.RoundTrip[v20260501.Proxy]{
+		Version: "2026-05-01",
+		Schema:  "proxy",
+		Convert: func(t *testing.T, proxy *v20260501.Proxy) any {
+			model, diags := fromAPI(ctx, proxy)
+			require.False(t, diags.HasError(), diags)
+			got, diags := model.toAPI(ctx)
+			require.False(t, diags.HasError(), diags)
+			return got
+		},
+	}.Run(t)
+}
@@ -29,7 +29,7 @@ var providerFactories = map[string]func() (tfprotov6.ProviderServer, error){
 func TestAccProxyResource(t *testing.T) {
 	credential := utils.NewCredential(t)
 	credentialID := *credential.Id
-	proxyName := "tfprovider-" + utils.Slug(t)
+	proxyName := utils.Slug(t)
 
 	helper.Test(t, helper.TestCase{
 		ProtoV6ProviderFactories: providerFactories,
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stepContext is the part of a step CLI context used to connect to the
+// Smallstep API.
+type stepContext struct {
+	APIURL          string
+	TeamSlug        string
+	TeamID          string
+	CertificateFile string
+	PrivateKeyFile  string
+}
+
+// stepDefaults are the keys read from a context's config/defaults.json.
+type stepDefaults struct {
+	APIURL   string `json:"api-url"`
+	Team     string `json:"team"`
+	TeamID   string `json:"team-id"`
+	X5CCert  string `json:"x5c-cert"`
+	X5CKey   string `json:"x5c-key"`
+	basePath string
+}
+
+// stepPath returns the step CLI config directory, $STEPPATH or ~/.step.
+func stepPath() (string, error) {
+	if p := os.Getenv("STEPPATH"); p != "" {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the step config directory: %w", err)
+	}
+	return filepath.Join(home, ".step"), nil
+}
+
+// loadStepContext reads the named context from contexts.json in the step
+// config directory. The defaults of the context's authority are read first and
+// then overridden by the defaults of its profile, the same way the step CLI
+// does. Relative paths are resolved against the directory of the defaults
+// file that set them.
+func loadStepContext(base, name string) (*stepContext, error) {
+	b, err := os.ReadFile(filepath.Join(base, "contexts.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read step contexts: %w", err)
+	}
+	var contexts map[string]struct {
+		Authority string `json:"authority"`
+		Profile   string `json:"profile"`
+	}
+	if err := json.Unmarshal(b, &contexts); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Join(base, "contexts.json"), err)
+	}
+	ctx, ok := contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("step context %q not found in %s", name, filepath.Join(base, "contexts.json"))
+	}
+
+	sc := &stepContext{}
+	for _, dir := range []string{
+		filepath.Join(base, "authorities", ctx.Authority),
+		filepath.Join(base, "profiles", ctx.Profile),
+	} {
+		d, err := readStepDefaults(dir)
+		if err != nil {
+			return nil, err
+		}
+		sc.merge(d)
+	}
+
+	return sc, nil
+}
+
+func readStepDefaults(dir string) (*stepDefaults, error) {
+	filename := filepath.Join(dir, "config", "defaults.json")
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &stepDefaults{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read step defaults: %w", err)
+	}
+	d := &stepDefaults{basePath: dir}
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+	return d, nil
+}
+
+func (sc *stepContext) merge(d *stepDefaults) {
+	abs := func(p string) string {
+		if p == "" || filepath.IsAbs(p) {
+			return p
+		}
+		return filepath.Join(d.basePath, p)
+	}
+	for dst, src := range map[*string]string{
+		&sc.APIURL:          d.APIURL,
+		&sc.TeamSlug:        d.Team,
+		&sc.TeamID:          d.TeamID,
+		&sc.CertificateFile: abs(d.X5CCert),
+		&sc.PrivateKeyFile:  abs(d.X5CKey),
+	} {
+		if src != "" {
+			*dst = src
+		}
+	}
+}
+
+// apply fills the team and client certificate the provider configuration does
+// not set. The context's client certificate is only used when neither a
+// client certificate nor a bearer token is configured, either in the provider
+// configuration or with the SMALLSTEP_API_TOKEN environment variable.
+func (sc *stepContext) apply(data *SmallstepProviderModel) {
+	if data.TeamSlug.IsNull() && sc.TeamSlug != "" {
+		data.TeamSlug = types.StringValue(sc.TeamSlug)
+	}
+	hasToken := !data.BearerToken.IsNull() || os.Getenv("SMALLSTEP_API_TOKEN") != ""
+	if data.ClientCertificate == nil && !hasToken && sc.CertificateFile != "" && sc.PrivateKeyFile != "" {
+		data.ClientCertificate = &ClientCertificateModel{
+			CertificateFile: types.StringValue(sc.CertificateFile),
+			PrivateKeyFile:  types.StringValue(sc.PrivateKeyFile),
+		}
+	}
+	if data.ClientCertificate != nil && data.ClientCertificate.TeamID.IsNull() && sc.TeamID != "" {
+		data.ClientCertificate.TeamID = types.StringValue(sc.TeamID)
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadStepContext(t *testing.T) {
+	base := filepath.Join("testdata", "step")
+	profile := filepath.Join(base, "profiles", "example")
+
+	tests := []struct {
+		name    string
+		context string
+		want    *stepContext
+		wantErr string
+	}{
+		{
+			name:    "authority and profile",
+			context: "example",
+			want: &stepContext{
+				TeamSlug:        "example",
+				TeamID:          "94a7dd82-1360-4493-b1bf-b14a97c45786",
+				CertificateFile: filepath.Join(profile, "api.crt"),
+				PrivateKeyFile:  filepath.Join(profile, "secrets", "api.key"),
+			},
+		},
+		{
+			name:    "profile overrides authority",
+			context: "staging",
+			want: &stepContext{
+				APIURL:          "https://gateway.staging.smallstep.com/api",
+				TeamSlug:        "example-staging",
+				TeamID:          "94a7dd82-1360-4493-b1bf-b14a97c45786",
+				CertificateFile: filepath.Join(profile, "api.crt"),
+				PrivateKeyFile:  filepath.Join(profile, "secrets", "api.key"),
+			},
+		},
+		{
+			name:    "no defaults",
+			context: "no-defaults",
+			want:    &stepContext{},
+		},
+		{
+			name:    "missing context",
+			context: "prod",
+			wantErr: `step context "prod" not found`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := loadStepContext(base, tc.context)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLoadStepContext_missingDirectory(t *testing.T) {
+	_, err := loadStepContext(t.TempDir(), "example")
+	require.ErrorContains(t, err, "failed to read step contexts")
+}
+
+func TestStepContextApply(t *testing.T) {
+	t.Setenv("SMALLSTEP_API_TOKEN", "")
+	sc := &stepContext{
+		TeamSlug:        "example",
+		TeamID:          "94a7dd82-1360-4493-b1bf-b14a97c45786",
+		CertificateFile: "/home/user/.step/api.crt",
+		PrivateKeyFile:  "/home/user/.step/api.key",
+	}
+
+	t.Run("empty config", func(t *testing.T) {
+		data := &SmallstepProviderModel{}
+		sc.apply(data)
+		assert.Equal(t, "example", data.TeamSlug.ValueString())
+		require.NotNil(t, data.ClientCertificate)
+		assert.Equal(t, "/home/user/.step/api.crt", data.ClientCertificate.CertificateFile.ValueString())
+		assert.Equal(t, "/home/user/.step/api.key", data.ClientCertificate.PrivateKeyFile.ValueString())
+		assert.Equal(t, "94a7dd82-1360-4493-b1bf-b14a97c45786", data.ClientCertificate.TeamID.ValueString())
+		assert.True(t, data.ClientCertificate.Certificate.IsNull())
+	})
+
+	t.Run("provider config wins", func(t *testing.T) {
+		data := &SmallstepProviderModel{
+			TeamSlug: types.StringValue("other"),
+			ClientCertificate: &ClientCertificateModel{
+				PKCS12File: types.StringValue("api.p12"),
+				TeamID:     types.StringValue("2f0a3c9e-7a59-4b43-9b1e-2d4a5e6f7a8b"),
+			},
+		}
+		sc.apply(data)
+		assert.Equal(t, "other", data.TeamSlug.ValueString())
+		assert.Equal(t, "api.p12", data.ClientCertificate.PKCS12File.ValueString())
+		assert.True(t, data.ClientCertificate.CertificateFile.IsNull())
+		assert.Equal(t, "2f0a3c9e-7a59-4b43-9b1e-2d4a5e6f7a8b", data.ClientCertificate.TeamID.ValueString())
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		data := &SmallstepProviderModel{BearerToken: types.StringValue("ey...")}
+		sc.apply(data)
+		assert.Nil(t, data.ClientCertificate)
+		assert.Equal(t, "example", data.TeamSlug.ValueString())
+	})
+
+	t.Run("bearer token environment variable", func(t *testing.T) {
+		t.Setenv("SMALLSTEP_API_TOKEN", "ey...")
+		data := &SmallstepProviderModel{}
+		sc.apply(data)
+		assert.Nil(t, data.ClientCertificate)
+		assert.Equal(t, "example", data.TeamSlug.ValueString())
+	})
+}
@@ -0,0 +1,690 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/pagination"
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The sweepers of every resource type are registered in this package, since
+// the sweepers they depend on must be registered in the same test binary.
+// smallstep_api_object has no sweeper because its objects have no common
+// name to recognize them by.
+
+func TestMain(m *testing.M) {
+	helper.TestMain(m)
+}
+
+// sweepPrefix starts the name of every object the tests create.
+const sweepPrefix = "tfprovider-"
+
+func init() {
+	helper.AddTestSweepers("smallstep_authority", &helper.Sweeper{
+		Name: "smallstep_authority",
+		// Relays reference the authority that issues their certificate.
+		Dependencies: []string{"smallstep_relay"},
+		F:            sweepAuthorities,
+	})
+	helper.AddTestSweepers("smallstep_provisioner", &helper.Sweeper{
+		Name: "smallstep_provisioner",
+		F:    sweepProvisioners,
+	})
+	helper.AddTestSweepers("smallstep_provisioner_webhook", &helper.Sweeper{
+		Name: "smallstep_provisioner_webhook",
+		F:    sweepWebhooks,
+	})
+	helper.AddTestSweepers("smallstep_device", &helper.Sweeper{
+		Name: "smallstep_device",
+		F:    sweepDevices,
+	})
+	helper.AddTestSweepers("smallstep_managed_radius", &helper.Sweeper{
+		Name: "smallstep_managed_radius",
+		F:    sweepManagedRadius,
+	})
+	helper.AddTestSweepers("smallstep_identity_provider", &helper.Sweeper{
+		Name: "smallstep_identity_provider",
+		Dependencies: []string{
+			"smallstep_identity_provider_client",
+			"smallstep_sso_integration",
+		},
+		F: sweepIdentityProvider,
+	})
+	helper.AddTestSweepers("smallstep_identity_provider_client", &helper.Sweeper{
+		Name: "smallstep_identity_provider_client",
+		F:    sweepIdentityProviderClients,
+	})
+	helper.AddTestSweepers("smallstep_sso_integration", &helper.Sweeper{
+		Name: "smallstep_sso_integration",
+		F:    sweepSSOIntegrations,
+	})
+	helper.AddTestSweepers("smallstep_credential", &helper.Sweeper{
+		Name: "smallstep_credential",
+		// A credential can't be deleted while it's referenced.
+		Dependencies: []string{
+			"smallstep_wifi",
+			"smallstep_vpn",
+			"smallstep_browser",
+			"smallstep_ethernet",
+			"smallstep_proxy",
+			"smallstep_workload",
+		},
+		F: sweepCredentials,
+	})
+	helper.AddTestSweepers("smallstep_wifi", &helper.Sweeper{
+		Name: "smallstep_wifi",
+		F:    sweepWifi,
+	})
+	helper.AddTestSweepers("smallstep_vpn", &helper.Sweeper{
+		Name: "smallstep_vpn",
+		F:    sweepVPNs,
+	})
+	helper.AddTestSweepers("smallstep_browser", &helper.Sweeper{
+		Name: "smallstep_browser",
+		F:    sweepBrowsers,
+	})
+	helper.AddTestSweepers("smallstep_ethernet", &helper.Sweeper{
+		Name: "smallstep_ethernet",
+		F:    sweepEthernet,
+	})
+	helper.AddTestSweepers("smallstep_proxy", &helper.Sweeper{
+		Name: "smallstep_proxy",
+		F:    sweepProxies,
+	})
+	helper.AddTestSweepers("smallstep_relay", &helper.Sweeper{
+		Name: "smallstep_relay",
+		F:    sweepRelays,
+	})
+	helper.AddTestSweepers("smallstep_workload", &helper.Sweeper{
+		Name: "smallstep_workload",
+		F:    sweepWorkloads,
+	})
+}
+
+// sweepCutoff returns the time objects must have been created before to be
+// swept, so objects used by tests that are still running are kept. The age
+// defaults to a minute and can be set with SWEEP_AGE. Only authorities and
+// provisioners report when they were created; other objects are swept by
+// name alone.
+func sweepCutoff() (time.Time, error) {
+	age := time.Minute
+	if sweepAge := os.Getenv("SWEEP_AGE"); sweepAge != "" {
+		d, err := time.ParseDuration(sweepAge)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid SWEEP_AGE: %w", err)
+		}
+		age = d
+	}
+	return time.Now().Add(-age), nil
+}
+
+// swept checks the response to a delete request. An object that is already
+// gone counts as swept.
+func swept(what, name string, resp *http.Response, err error) error {
+	if err != nil {
+		return fmt.Errorf("failed to delete %s %q: %w", what, name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to delete %s %q: %d: %s", what, name, resp.StatusCode, body)
+	}
+	log.Printf("Successfully swept %s %s\n", what, name)
+	return nil
+}
+
+func listAuthorities(ctx context.Context, client *v20250101.Client) ([]*v20250101.Authority, error) {
+	return pagination.List[*v20250101.Authority]("authorities", func() (*http.Response, error) {
+		return client.GetAuthorities(ctx, &v20250101.GetAuthoritiesParams{})
+	})
+}
+
+func sweepAuthorities(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+	cutoff, err := sweepCutoff()
+	if err != nil {
+		return err
+	}
+
+	list, err := listAuthorities(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, authority := range list {
+		if !strings.HasPrefix(authority.Domain, sweepPrefix) || authority.CreatedAt.After(cutoff) {
+			continue
+		}
+		resp, err := client.DeleteAuthority(ctx, authority.Id, &v20250101.DeleteAuthorityParams{})
+		errs = append(errs, swept("authority", authority.Domain, resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+// sweptWithAuthority reports whether an authority is deleted by the
+// smallstep_authority sweeper with all its provisioners and webhooks, or is
+// used by a running test.
+func sweptWithAuthority(authority *v20250101.Authority) bool {
+	return strings.HasPrefix(authority.Domain, sweepPrefix)
+}
+
+func sweepProvisioners(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+	cutoff, err := sweepCutoff()
+	if err != nil {
+		return err
+	}
+
+	authorities, err := listAuthorities(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, authority := range authorities {
+		if sweptWithAuthority(authority) {
+			continue
+		}
+		list, err := pagination.List[*v20250101.Provisioner]("provisioners", func() (*http.Response, error) {
+			return client.ListAuthorityProvisioners(ctx, authority.Id, &v20250101.ListAuthorityProvisionersParams{})
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, provisioner := range list {
+			if !strings.HasPrefix(provisioner.Name, sweepPrefix) || provisioner.CreatedAt == nil || provisioner.CreatedAt.After(cutoff) {
+				continue
+			}
+			resp, err := client.DeleteProvisioner(ctx, authority.Id, utils.Deref(provisioner.Id), &v20250101.DeleteProvisionerParams{})
+			errs = append(errs, swept("provisioner", provisioner.Name, resp, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepWebhooks(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	authorities, err := listAuthorities(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, authority := range authorities {
+		if sweptWithAuthority(authority) {
+			continue
+		}
+		list, err := pagination.List[*v20250101.Provisioner]("provisioners", func() (*http.Response, error) {
+			return client.ListAuthorityProvisioners(ctx, authority.Id, &v20250101.ListAuthorityProvisionersParams{})
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, provisioner := range list {
+			if provisioner.Options == nil || provisioner.Options.Webhooks == nil {
+				continue
+			}
+			for _, webhook := range *provisioner.Options.Webhooks {
+				if !strings.HasPrefix(webhook.Name, sweepPrefix) {
+					continue
+				}
+				resp, err := client.DeleteWebhook(ctx, authority.Id, utils.Deref(provisioner.Id), webhook.Name, &v20250101.DeleteWebhookParams{})
+				errs = append(errs, swept("webhook", webhook.Name, resp, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepDevices(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20250101.Device]("devices", func(after *string) (*http.Response, error) {
+		return client.ListDevices(ctx, &v20250101.ListDevicesParams{
+			Pagination: pagination.V20250101(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, device := range list {
+		if !strings.HasPrefix(device.PermanentIdentifier, sweepPrefix) && !strings.HasPrefix(utils.Deref(device.DisplayName), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteDevice(ctx, device.Id, &v20250101.DeleteDeviceParams{})
+		errs = append(errs, swept("device", device.PermanentIdentifier, resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepManagedRadius(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.List[*v20250101.ManagedRadius]("managed radius", func() (*http.Response, error) {
+		return client.ListManagedRadius(ctx, &v20250101.ListManagedRadiusParams{})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, radius := range list {
+		if !strings.HasPrefix(radius.Name, sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteManagedRadius(ctx, utils.Deref(radius.Id), &v20250101.DeleteManagedRadiusParams{})
+		errs = append(errs, swept("managed radius", radius.Name, resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+// sweepIdentityProvider deletes the team's identity provider. It has no name
+// to recognize it by, but a team can only have one and the tests replace it.
+func sweepIdentityProvider(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteIdentityProvider(ctx, &v20250101.DeleteIdentityProviderParams{})
+	return swept("identity provider", "", resp, err)
+}
+
+// sweepIdentityProviderClients deletes clients with a redirect URI path that
+// starts with the prefix, e.g. https://example.com/tfprovider-abc.
+func sweepIdentityProviderClients(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.List[*v20250101.IdpClient]("identity provider clients", func() (*http.Response, error) {
+		return client.ListIdpClients(ctx, &v20250101.ListIdpClientsParams{})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, idpClient := range list {
+		u, err := url.Parse(idpClient.RedirectURI)
+		if err != nil || !strings.HasPrefix(strings.TrimPrefix(u.Path, "/"), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteIdpClient(ctx, utils.Deref(idpClient.Id), &v20250101.DeleteIdpClientParams{})
+		errs = append(errs, swept("identity provider client", idpClient.RedirectURI, resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+// sweepSSOIntegrations deletes integrations with a redirect URI path that
+// starts with the prefix, like sweepIdentityProviderClients.
+func sweepSSOIntegrations(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientV20260501FromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20260501.SsoIntegration]("SSO integrations", func(after *string) (*http.Response, error) {
+		return client.ListSsoIntegrations(ctx, &v20260501.ListSsoIntegrationsParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, integration := range list {
+		u, err := url.Parse(integration.RedirectURI)
+		if err != nil || !strings.HasPrefix(strings.TrimPrefix(u.Path, "/"), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteSsoIntegration(ctx, utils.Deref(integration.Id), &v20260501.DeleteSsoIntegrationParams{})
+		errs = append(errs, swept("SSO integration", integration.RedirectURI, resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepCredentials(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientV20260501FromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20260501.Credential]("credentials", func(after *string) (*http.Response, error) {
+		return client.ListCredentials(ctx, &v20260501.ListCredentialsParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, credential := range list {
+		if !strings.HasPrefix(credential.Slug, sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteCredential(ctx, utils.Deref(credential.Id), &v20260501.DeleteCredentialParams{})
+		errs = append(errs, swept("credential", credential.Slug, resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepWifi(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20250101.Wifi]("wifi", func(after *string) (*http.Response, error) {
+		return client.ListWifi(ctx, &v20250101.ListWifiParams{
+			Pagination: pagination.V20250101(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, wifi := range list {
+		if !strings.HasPrefix(utils.Deref(wifi.Name), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteWifi(ctx, utils.Deref(wifi.Id), &v20250101.DeleteWifiParams{})
+		errs = append(errs, swept("wifi", utils.Deref(wifi.Name), resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepVPNs(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20250101.Vpn]("vpn", func(after *string) (*http.Response, error) {
+		return client.ListVpn(ctx, &v20250101.ListVpnParams{
+			Pagination: pagination.V20250101(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, vpn := range list {
+		if !strings.HasPrefix(utils.Deref(vpn.Name), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteVpn(ctx, utils.Deref(vpn.Id), &v20250101.DeleteVpnParams{})
+		errs = append(errs, swept("vpn", utils.Deref(vpn.Name), resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepBrowsers(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20250101.Browser]("browser", func(after *string) (*http.Response, error) {
+		return client.ListBrowser(ctx, &v20250101.ListBrowserParams{
+			Pagination: pagination.V20250101(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, browser := range list {
+		if !strings.HasPrefix(utils.Deref(browser.Name), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteBrowser(ctx, utils.Deref(browser.Id), &v20250101.DeleteBrowserParams{})
+		errs = append(errs, swept("browser", utils.Deref(browser.Name), resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepEthernet(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20250101.Ethernet]("ethernet", func(after *string) (*http.Response, error) {
+		return client.ListEthernet(ctx, &v20250101.ListEthernetParams{
+			Pagination: pagination.V20250101(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, ethernet := range list {
+		if !strings.HasPrefix(utils.Deref(ethernet.Name), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteEthernet(ctx, utils.Deref(ethernet.Id), &v20250101.DeleteEthernetParams{})
+		errs = append(errs, swept("ethernet", utils.Deref(ethernet.Name), resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepProxies(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientV20260501FromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20260501.Proxy]("proxies", func(after *string) (*http.Response, error) {
+		return client.ListProxy(ctx, &v20260501.ListProxyParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, proxy := range list {
+		if !strings.HasPrefix(utils.Deref(proxy.Name), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteProxy(ctx, utils.Deref(proxy.Id), &v20260501.DeleteProxyParams{})
+		errs = append(errs, swept("proxy", utils.Deref(proxy.Name), resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepRelays(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientV20260501FromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20260501.Relay]("relays", func(after *string) (*http.Response, error) {
+		return client.ListRelays(ctx, &v20260501.ListRelaysParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, relay := range list {
+		if !strings.HasPrefix(relay.Name, sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteRelay(ctx, utils.Deref(relay.Id), &v20260501.DeleteRelayParams{})
+		errs = append(errs, swept("relay", relay.Name, resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sweepWorkloads(region string) error {
+	ctx := context.Background()
+
+	client, err := utils.SmallstepAPIClientV20260501FromEnv()
+	if err != nil {
+		return err
+	}
+
+	list, err := pagination.All[*v20260501.Workload]("workloads", func(after *string) (*http.Response, error) {
+		return client.ListWorkloads(ctx, &v20260501.ListWorkloadsParams{
+			Pagination: pagination.V20260501(after),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, workload := range list {
+		if !strings.HasPrefix(utils.Deref(workload.Name), sweepPrefix) {
+			continue
+		}
+		resp, err := client.DeleteWorkload(ctx, utils.Deref(workload.Id), &v20260501.DeleteWorkloadParams{})
+		errs = append(errs, swept("workload", utils.Deref(workload.Name), resp, err))
+	}
+	return errors.Join(errs...)
+}
+
+func TestSweepers(t *testing.T) {
+	if os.Getenv("SMALLSTEP_API_TOKEN") != "" {
+		t.Skip("sweepers are only tested against the fake API")
+	}
+	ctx := t.Context()
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	require.NoError(t, err)
+
+	postDevice := func(permanentID string) string {
+		resp, err := client.PostDevices(ctx, &v20250101.PostDevicesParams{}, v20250101.DeviceRequest{
+			PermanentIdentifier: permanentID,
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		device := &v20250101.Device{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(device))
+		return device.Id
+	}
+	getDevice := func(id string) int {
+		resp, err := client.GetDevice(ctx, id, &v20250101.GetDeviceParams{})
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	kept := postDevice("keep-" + uuid.NewString())
+	sweptID := postDevice(sweepPrefix + uuid.NewString())
+	require.NoError(t, sweepDevices(""))
+	assert.Equal(t, http.StatusOK, getDevice(kept))
+	assert.Equal(t, http.StatusNotFound, getDevice(sweptID))
+	t.Cleanup(func() {
+		resp, err := client.DeleteDevice(context.Background(), kept, &v20250101.DeleteDeviceParams{})
+		require.NoError(t, err)
+		resp.Body.Close()
+	})
+
+	// A new authority is kept until it's older than SWEEP_AGE.
+	resp, err := client.PostAuthorities(ctx, &v20250101.PostAuthoritiesParams{}, v20250101.PostAuthoritiesJSONRequestBody{
+		Name:        "Sweep",
+		AdminEmails: []string{"eng@smallstep.com"},
+		Subdomain:   sweepPrefix + "sweep",
+		Type:        "devops",
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	authority := &v20250101.Authority{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(authority))
+
+	require.NoError(t, sweepAuthorities(""))
+	resp, err = client.GetAuthority(ctx, authority.Id, &v20250101.GetAuthorityParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	t.Setenv("SWEEP_AGE", "0s")
+	require.NoError(t, sweepAuthorities(""))
+	resp, err = client.GetAuthority(ctx, authority.Id, &v20250101.GetAuthorityParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMaxWait = 30 * time.Second
+	retryMinWait        = time.Second
+)
+
+// transportConfig holds the provider settings applied to every HTTP request
+// made to the Smallstep API, including token requests. The limiter and
+// in-flight semaphore are shared by every round tripper created by wrap so the
+// limits apply provider-wide.
+type transportConfig struct {
+	maxRetries   int
+	retryMaxWait time.Duration
+	limiter      *rate.Limiter
+	inFlight     chan struct{}
+	userAgent    string
+
+	rootCAs            *x509.CertPool
+	proxyURL           *url.URL
+	insecureSkipVerify bool
+}
+
+func defaultTransportConfig() transportConfig {
+	return transportConfig{
+		maxRetries:   defaultMaxRetries,
+		retryMaxWait: defaultRetryMaxWait,
+	}
+}
+
+// withRateLimit limits requests to requestsPerSecond with a burst of one
+// second's worth of requests. Zero disables the limit.
+func (c transportConfig) withRateLimit(requestsPerSecond float64) transportConfig {
+	if requestsPerSecond > 0 {
+		burst := int(math.Ceil(requestsPerSecond))
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return c
+}
+
+// withMaxConcurrentRequests limits the number of requests in flight. Zero
+// disables the limit.
+func (c transportConfig) withMaxConcurrentRequests(n int) transportConfig {
+	if n > 0 {
+		c.inFlight = make(chan struct{}, n)
+	}
+	return c
+}
+
+// newBaseTransport returns the transport that sends requests to the API with
+// the configured trusted roots and proxy. The proxy defaults to the one set in
+// the HTTPS_PROXY and NO_PROXY environment variables.
+func (c transportConfig) newBaseTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:            c.rootCAs,
+		InsecureSkipVerify: c.insecureSkipVerify, //nolint:gosec // opt-in for local stand-ins of the API
+		MinVersion:         tls.VersionTLS12,
+	}
+	if c.proxyURL != nil {
+		transport.Proxy = http.ProxyURL(c.proxyURL)
+	}
+	return transport
+}
+
+// wrap returns base wrapped with the round trippers enabled by the config.
+// Every attempt is logged and limits are applied to every attempt, so retries
+// wait their turn too.
+func (c transportConfig) wrap(base http.RoundTripper) http.RoundTripper {
+	base = &loggingTransport{base: base}
+	if c.limiter != nil || c.inFlight != nil {
+		base = &limitTransport{
+			base:     base,
+			limiter:  c.limiter,
+			inFlight: c.inFlight,
+		}
+	}
+	if c.maxRetries > 0 {
+		base = &retryTransport{
+			base:       base,
+			maxRetries: c.maxRetries,
+			maxWait:    c.retryMaxWait,
+			sleep:      sleepContext,
+		}
+	}
+	return &headerTransport{
+		base:      base,
+		userAgent: c.userAgent,
+	}
+}
+
+// headerTransport sets the User-Agent and X-Request-Id headers on every
+// request. A request ID set by the caller is kept. Retries of a request reuse
+// its request ID.
+type headerTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func (t *headerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r2 := r.Clone(r.Context())
+	if t.userAgent != "" {
+		r2.Header.Set("User-Agent", t.userAgent)
+	}
+	if r2.Header.Get("X-Request-Id") == "" {
+		r2.Header.Set("X-Request-Id", utils.NextRequestID(r.Context()))
+	}
+	return t.base.RoundTrip(r2)
+}
+
+// limitTransport shapes requests with a token bucket and caps the number of
+// requests in flight. A request holds its slot until its response body is
+// closed.
+type limitTransport struct {
+	base     http.RoundTripper
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+}
+
+func (t *limitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	if t.inFlight != nil {
+		select {
+		case t.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if t.inFlight != nil {
+			<-t.inFlight
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// retryTransport retries requests that failed with a transport error, a 429 or
+// a 5xx response. Requests that are not idempotent are only retried after a
+// 429 since the API did not process them. The Retry-After header is honored
+// up to maxWait, otherwise the wait is an exponential backoff with jitter.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+	sleep      func(context.Context, time.Duration) error
+}
+
+func (t *retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req := r
+		if attempt > 0 && r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = r.Clone(r.Context())
+			req.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.maxRetries || !t.shouldRetry(r, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			// Drain the body so the connection can be reused.
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+		if err := t.sleep(r.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(r *http.Request, resp *http.Response, err error) bool {
+	if r.Context().Err() != nil {
+		return false
+	}
+	// The body can't be sent again.
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(r.Method)
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(r.Method)
+	default:
+		return false
+	}
+}
+
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.maxWait)
+		}
+	}
+	wait := retryMinWait << attempt
+	// Add up to 50% jitter so parallel operations don't retry in lockstep.
+	wait += time.Duration(rand.Int63n(int64(wait)/2 + 1))
+	return min(wait, t.maxWait)
+}
+
+// retryAfter parses the Retry-After header as either a number of seconds or
+// an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,465 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingServer responds with the given statuses in order and then 200.
+func failingServer(t *testing.T, headers http.Header, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if r.Body != nil {
+			b, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			if r.Method == http.MethodPost {
+				assert.Equal(t, `{"name":"retry"}`, strings.TrimSpace(string(b)))
+			}
+		}
+		if n <= len(statuses) {
+			for k, v := range headers {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newRetryTransport(maxRetries int, waits *[]time.Duration) *retryTransport {
+	return &retryTransport{
+		base:       http.DefaultTransport,
+		maxRetries: maxRetries,
+		maxWait:    10 * time.Second,
+		sleep: func(_ context.Context, d time.Duration) error {
+			*waits = append(*waits, d)
+			return nil
+		},
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		headers    http.Header
+		statuses   []int
+		maxRetries int
+		wantStatus int
+		wantCalls  int32
+		wantWaits  []time.Duration
+	}{
+		{
+			name:       "get retried after server errors",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusBadGateway},
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		{
+			name:       "get gives up after max retries",
+			method:     http.MethodGet,
+			statuses:   []int{500, 500, 500, 500, 500},
+			maxRetries: 2,
+			wantStatus: http.StatusInternalServerError,
+			wantCalls:  3,
+		},
+		{
+			name:       "retry after seconds",
+			method:     http.MethodDelete,
+			headers:    http.Header{"Retry-After": []string{"2"}},
+			statuses:   []int{http.StatusTooManyRequests},
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+			wantWaits:  []time.Duration{2 * time.Second},
+		},
+		{
+			name:       "retry after capped at max wait",
+			method:     http.MethodGet,
+			headers:    http.Header{"Retry-After": []string{"3600"}},
+			statuses:   []int{http.StatusTooManyRequests},
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+			wantWaits:  []time.Duration{10 * time.Second},
+		},
+		{
+			name:       "post retried after rate limit",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests},
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		{
+			name:       "post not retried after server error",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusServiceUnavailable},
+			maxRetries: 3,
+			wantStatus: http.StatusServiceUnavailable,
+			wantCalls:  1,
+		},
+		{
+			name:       "client errors not retried",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusBadRequest},
+			maxRetries: 3,
+			wantStatus: http.StatusBadRequest,
+			wantCalls:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, calls := failingServer(t, tc.headers, tc.statuses...)
+			var waits []time.Duration
+			client := &http.Client{Transport: newRetryTransport(tc.maxRetries, &waits)}
+
+			var body io.Reader
+			if tc.method == http.MethodPost {
+				body = strings.NewReader(`{"name":"retry"}`)
+			}
+			req, err := http.NewRequestWithContext(t.Context(), tc.method, srv.URL, body)
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			assert.Equal(t, tc.wantCalls, calls.Load())
+			assert.Len(t, waits, int(tc.wantCalls)-1)
+			if tc.wantWaits != nil {
+				assert.Equal(t, tc.wantWaits, waits)
+			}
+			for _, w := range waits {
+				assert.LessOrEqual(t, w, 10*time.Second)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	tr := &retryTransport{maxWait: 5 * time.Second}
+	for attempt, min := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		wait := tr.backoff(attempt, nil)
+		assert.GreaterOrEqual(t, wait, min)
+		assert.LessOrEqual(t, wait, 5*time.Second)
+	}
+}
+
+func TestRetryTransport_canceled(t *testing.T) {
+	srv, calls := failingServer(t, nil, 503, 503, 503)
+	ctx, cancel := context.WithCancel(t.Context())
+	client := &http.Client{Transport: &retryTransport{
+		base:       http.DefaultTransport,
+		maxRetries: 3,
+		maxWait:    time.Second,
+		sleep: func(ctx context.Context, d time.Duration) error {
+			cancel()
+			return sleepContext(ctx, d)
+		},
+	}}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_generatedClient(t *testing.T) {
+	srv, calls := failingServer(t, http.Header{"Retry-After": []string{"0"}}, http.StatusTooManyRequests, http.StatusTooManyRequests)
+
+	clients, err := newClientset(srv.URL, clientset.Version20250101, &http.Client{
+		Transport: &tokenTransport{
+			source: staticTokenSource("abc"),
+			base:   defaultTransportConfig().wrap(http.DefaultTransport),
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := clients.V20250101.GetAuthority(t.Context(), "abc", &v20250101.GetAuthorityParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryAfter(t *testing.T) {
+	d, ok := retryAfter("5")
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, d)
+
+	d, ok = retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute, d, float64(2*time.Second))
+
+	_, ok = retryAfter("")
+	assert.False(t, ok)
+
+	_, ok = retryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestLimitTransport_maxConcurrentRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	cfg := transportConfig{}.withMaxConcurrentRequests(2)
+	// Clients built from the same config share the limit.
+	clients := []*http.Client{
+		{Transport: cfg.wrap(http.DefaultTransport)},
+		{Transport: cfg.wrap(http.DefaultTransport)},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(client *http.Client) {
+			defer wg.Done()
+			resp, err := client.Get(srv.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}(clients[i%2])
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), peak.Load())
+	assert.Len(t, cfg.inFlight, 0)
+}
+
+func TestLimitTransport_requestsPerSecond(t *testing.T) {
+	srv, calls := failingServer(t, nil)
+
+	cfg := transportConfig{}.withRateLimit(20)
+	client := &http.Client{Transport: cfg.wrap(http.DefaultTransport)}
+
+	// The first 20 requests use the burst and the next 10 are spread over
+	// half a second.
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	assert.Equal(t, int32(30), calls.Load())
+}
+
+func TestLimitTransport_canceled(t *testing.T) {
+	srv, _ := failingServer(t, nil)
+
+	cfg := transportConfig{}.withMaxConcurrentRequests(1)
+	client := &http.Client{Transport: cfg.wrap(http.DefaultTransport)}
+
+	// Hold the only slot by leaving the body open.
+	held, err := client.Get(srv.URL)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	held.Body.Close()
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestTokenTransport_refreshWithSingleSlot(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time { return clock }
+	authServer := newFakeAuthServer(t, now)
+
+	cfg := transportConfig{}.withMaxConcurrentRequests(1)
+	transport := authServer.Client().Transport.(*http.Transport).Clone()
+	source, err := newClientCertTokenSource(authServer.URL, "", "my-team", testClientCert(t), transport, cfg)
+	require.NoError(t, err)
+	source.now = now
+
+	revoked, err := source.Token(t.Context())
+	require.NoError(t, err)
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer "+revoked {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer api.Close()
+
+	client := &http.Client{Transport: &tokenTransport{
+		source: source,
+		base:   cfg.wrap(http.DefaultTransport),
+	}}
+	resp, err := client.Get(api.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHeaderTransport(t *testing.T) {
+	var ids []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "terraform-provider-smallstep/1.2.3 terraform/1.9.0", r.Header.Get("User-Agent"))
+		ids = append(ids, r.Header.Get("X-Request-Id"))
+		if len(ids) == 1 {
+			// The retry is sent with the same request ID.
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	cfg := defaultTransportConfig()
+	cfg.userAgent = "terraform-provider-smallstep/1.2.3 terraform/1.9.0"
+	clients, err := newClientset(srv.URL, clientset.Version20250101, &http.Client{Transport: cfg.wrap(http.DefaultTransport)})
+	require.NoError(t, err)
+
+	ctx := utils.WithRequestID(t.Context(), "smallstep_authority", "create")
+	for i := 0; i < 2; i++ {
+		resp, err := clients.V20250101.GetAuthority(ctx, "abc", &v20250101.GetAuthorityParams{})
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// A request ID set by the caller is kept.
+	resp, err := clients.V20250101.GetAuthority(ctx, "abc", &v20250101.GetAuthorityParams{
+		XRequestId: utils.Ref("custom-id"),
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// Requests made outside of a terraform operation get a provider ID.
+	resp, err = clients.V20250101.GetAuthority(t.Context(), "abc", &v20250101.GetAuthorityParams{})
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Len(t, ids, 5)
+	assert.Regexp(t, `^tf-smallstep_authority-create-[0-9a-f]{8}-1$`, ids[0])
+	assert.Equal(t, ids[0], ids[1])
+	assert.Equal(t, strings.TrimSuffix(ids[0], "-1")+"-2", ids[2])
+	assert.Equal(t, "custom-id", ids[3])
+	assert.Regexp(t, `^tf-provider-[0-9a-f]{8}$`, ids[4])
+}
+
+func TestBaseTransport(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, []byte(caPEM), 0o600))
+
+	tests := []struct {
+		name    string
+		data    SmallstepProviderModel
+		wantErr bool
+	}{
+		{"system roots", SmallstepProviderModel{}, true},
+		{"ca_bundle", SmallstepProviderModel{CABundle: types.StringValue(caPEM)}, false},
+		{"ca_bundle_file", SmallstepProviderModel{CABundleFile: types.StringValue(caFile)}, false},
+		{"insecure_skip_verify", SmallstepProviderModel{InsecureSkipTLS: types.BoolValue(true)}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, diags := tc.data.transportConfig()
+			require.False(t, diags.HasError(), "%v", diags)
+
+			client := &http.Client{Transport: cfg.wrap(cfg.newBaseTransport())}
+			resp, err := client.Get(srv.URL)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		})
+	}
+}
+
+func TestBaseTransport_httpProxy(t *testing.T) {
+	var proxied atomic.Value
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Store(r.URL.String())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+
+	data := SmallstepProviderModel{HTTPProxy: types.StringValue(proxy.URL)}
+	cfg, diags := data.transportConfig()
+	require.False(t, diags.HasError(), "%v", diags)
+
+	client := &http.Client{Transport: cfg.wrap(cfg.newBaseTransport())}
+	resp, err := client.Get("http://api.smallstep.invalid/authorities")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "http://api.smallstep.invalid/authorities", proxied.Load())
+}
+
+func TestTransportConfig_invalidTLSAndProxy(t *testing.T) {
+	tests := []struct {
+		name string
+		data SmallstepProviderModel
+	}{
+		{"ca_bundle", SmallstepProviderModel{CABundle: types.StringValue("not a certificate")}},
+		{"ca_bundle_file", SmallstepProviderModel{CABundleFile: types.StringValue(filepath.Join(t.TempDir(), "missing.crt"))}},
+		{"http_proxy", SmallstepProviderModel{HTTPProxy: types.StringValue("proxy.example.com")}},
+		{"insecure_skip_verify", SmallstepProviderModel{InsecureSkipTLS: types.BoolUnknown()}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, diags := tc.data.transportConfig()
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Summary(), tc.name)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func FuzzIsDurationEqual(f *testing.F) {
+	for _, seed := range [][2]string{
+		{"1h", "1h0m0s"},
+		{"90m", "1h30m"},
+		{"0", "0s"},
+		{"1h", "1h1s"},
+		{"", "0s"},
+		{"1.5h", "5400s"},
+		{"-1m", "-60s"},
+		{"abc", "abc"},
+	} {
+		f.Add(seed[0], seed[1])
+	}
+	f.Fuzz(func(t *testing.T, a, b string) {
+		got := IsDurationEqual(a, b)
+		if got != IsDurationEqual(b, a) {
+			t.Fatalf("IsDurationEqual(%q, %q) is not symmetric", a, b)
+		}
+		if !IsDurationEqual(a, a) {
+			t.Fatalf("IsDurationEqual(%q, %q) = false", a, a)
+		}
+		da, errA := time.ParseDuration(a)
+		db, errB := time.ParseDuration(b)
+		if errA != nil || errB != nil {
+			if got && a != b {
+				t.Fatalf("IsDurationEqual(%q, %q) = true for an invalid duration", a, b)
+			}
+			return
+		}
+		if got != (da == db) {
+			t.Fatalf("IsDurationEqual(%q, %q) = %v, durations are %v and %v", a, b, got, da, db)
+		}
+		// The API returns durations formatted by Go.
+		if !IsDurationEqual(a, da.String()) {
+			t.Fatalf("IsDurationEqual(%q, %q) = false", a, da.String())
+		}
+	})
+}
+
+func FuzzIsJSONEqual(f *testing.F) {
+	for _, seed := range [][2]string{
+		{`{"a":1,"b":[1,2]}`, `{"b":[1,2],"a":1}`},
+		{`{"a":1}`, `{"a":1.0}`},
+		{`{"a":null}`, `{}`},
+		{`[1,2]`, `[2,1]`},
+		{`"x"`, `"x"`},
+		{`{"a":`, `{"a":`},
+		{``, `null`},
+	} {
+		f.Add(seed[0], seed[1])
+	}
+	f.Fuzz(func(t *testing.T, a, b string) {
+		got := IsJSONEqual(a, b)
+		if got != IsJSONEqual(b, a) {
+			t.Fatalf("IsJSONEqual(%q, %q) is not symmetric", a, b)
+		}
+		if !IsJSONEqual(a, a) {
+			t.Fatalf("IsJSONEqual(%q, %q) = false", a, a)
+		}
+		if !json.Valid([]byte(a)) {
+			if got && a != b {
+				t.Fatalf("IsJSONEqual(%q, %q) = true for invalid JSON", a, b)
+			}
+			return
+		}
+		// Formatting doesn't matter.
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, []byte(a), "", "  "); err != nil {
+			t.Fatal(err)
+		}
+		if !IsJSONEqual(a, indented.String()) {
+			t.Fatalf("IsJSONEqual(%q, %q) = false", a, indented.String())
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.step.sm/crypto/randutil"
+)
+
+type requestIDKey struct{}
+
+type requestIDPrefix struct {
+	prefix string
+	n      atomic.Int64
+}
+
+// WithRequestID returns a context that tags every Smallstep API request made
+// with it with an X-Request-Id like tf-smallstep_authority-create-4f2a9c1b-1.
+// The random part is shared by all requests of a single terraform operation
+// and the counter distinguishes the requests within it, so a failed apply can
+// be found in Smallstep's logs from any one of its request IDs.
+func WithRequestID(ctx context.Context, typeName, operation string) context.Context {
+	id, err := randutil.Hex(8)
+	if err != nil {
+		id = "0"
+	}
+	return context.WithValue(ctx, requestIDKey{}, &requestIDPrefix{
+		prefix: fmt.Sprintf("tf-%s-%s-%s", typeName, operation, id),
+	})
+}
+
+// NextRequestID returns the request ID for the next API request made with ctx.
+// Requests made outside of a terraform operation, e.g. while configuring the
+// provider, get an ID prefixed with tf-provider.
+func NextRequestID(ctx context.Context) string {
+	p, ok := ctx.Value(requestIDKey{}).(*requestIDPrefix)
+	if !ok {
+		id, err := randutil.Hex(8)
+		if err != nil {
+			id = "0"
+		}
+		return "tf-provider-" + id
+	}
+	return fmt.Sprintf("%s-%d", p.prefix, p.n.Add(1))
+}
@@ -9,15 +9,22 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	randv2 "math/rand/v2"
 	"net/http"
 	"net/netip"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
 	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apispec"
+	"github.com/smallstep/terraform-provider-smallstep/internal/fakeapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.step.sm/crypto/jose"
@@ -38,17 +45,43 @@ func RelayHostname2() string {
 	return cmp.Or(os.Getenv("RELAY_HOSTNAME_2"), "relay2.example.com")
 }
 
-func SmallstepAPIClientFromEnv() (*v20250101.Client, error) {
+// apiFromEnv returns the API token and URL to test against. Without
+// SMALLSTEP_API_TOKEN tests run against an in-memory fake of the API.
+func apiFromEnv() (string, string, error) {
 	token := os.Getenv("SMALLSTEP_API_TOKEN")
 	if token == "" {
-		return nil, errors.New("missing environment variable SMALLSTEP_API_TOKEN")
+		return fakeapi.Token, fakeapi.Default().URL, nil
 	}
 	server := os.Getenv("SMALLSTEP_API_URL")
 	if server == "" {
-		return nil, errors.New("missing environment variable SMALLSTEP_API_URL")
+		return "", "", errors.New("missing environment variable SMALLSTEP_API_URL")
 	}
+	return token, server, nil
+}
 
-	client, err := v20250101.NewClient(server, v20250101.WithRequestEditorFn(v20250101.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
+// contractClient returns an HTTP client that fails any request or response
+// that doesn't match the API spec, so tests catch requests the API would reject
+// and responses the provider would misread.
+func contractClient(server string) (*http.Client, error) {
+	transport, err := apispec.NewTransport(server, http.DefaultTransport)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+func SmallstepAPIClientFromEnv() (*v20250101.Client, error) {
+	token, server, err := apiFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := contractClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v20250101.NewClient(server, v20250101.WithHTTPClient(httpClient), v20250101.WithRequestEditorFn(v20250101.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
 		r.Header.Set("X-Smallstep-Api-Version", "2025-01-01")
 		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 		return nil
@@ -59,18 +92,35 @@ func SmallstepAPIClientFromEnv() (*v20250101.Client, error) {
 	return client, nil
 }
 
+// APIVersionFromEnv returns the API version the resources are tested against,
+// set with SMALLSTEP_API_VERSION as for the provider's api_version.
+func APIVersionFromEnv() string {
+	return cmp.Or(os.Getenv("SMALLSTEP_API_VERSION"), clientset.Version20250101)
+}
+
 func SmallstepAPIClientV20260501FromEnv() (*v20260501.Client, error) {
-	token := os.Getenv("SMALLSTEP_API_TOKEN")
-	if token == "" {
-		return nil, errors.New("missing environment variable SMALLSTEP_API_TOKEN")
+	return smallstepAPIClientV20260501FromEnv(clientset.Version20260501)
+}
+
+// SmallstepAPIClientPinnedFromEnv returns a client with the 2026-05-01 types
+// that requests the API version from APIVersionFromEnv.
+func SmallstepAPIClientPinnedFromEnv() (*v20260501.Client, error) {
+	return smallstepAPIClientV20260501FromEnv(APIVersionFromEnv())
+}
+
+func smallstepAPIClientV20260501FromEnv(apiVersion string) (*v20260501.Client, error) {
+	token, server, err := apiFromEnv()
+	if err != nil {
+		return nil, err
 	}
-	server := os.Getenv("SMALLSTEP_API_URL")
-	if server == "" {
-		return nil, errors.New("missing environment variable SMALLSTEP_API_URL")
+
+	httpClient, err := contractClient(server)
+	if err != nil {
+		return nil, err
 	}
 
-	client, err := v20260501.NewClient(server, v20260501.WithRequestEditorFn(v20260501.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
-		r.Header.Set("X-Smallstep-Api-Version", "2026-05-01")
+	client, err := v20260501.NewClient(server, v20260501.WithHTTPClient(httpClient), v20260501.WithRequestEditorFn(v20260501.RequestEditorFn(func(ctx context.Context, r *http.Request) error {
+		r.Header.Set("X-Smallstep-Api-Version", apiVersion)
 		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 		return nil
 	})))
@@ -158,6 +208,46 @@ func NewOIDCProvisioner(t *testing.T, authorityID string) (*v20250101.Provisione
 	return provisioner, &oidc
 }
 
+func NewACMEProvisioner(t *testing.T, authorityID string) *v20250101.Provisioner {
+	client, err := SmallstepAPIClientFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := v20250101.Provisioner{
+		Name: "acme " + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum),
+		Type: "ACME",
+	}
+	acme := v20250101.AcmeProvisioner{
+		Challenges: []v20250101.AcmeProvisionerChallenges{v20250101.Http01},
+		RequireEAB: true,
+	}
+	if err := req.FromAcmeProvisioner(acme); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.PostAuthorityProvisioners(context.Background(), authorityID, &v20250101.PostAuthorityProvisionersParams{}, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		t.Fatalf("Failed to create provisioner: %d: %s", resp.StatusCode, body)
+	}
+
+	provisioner := &v20250101.Provisioner{}
+	if err := json.NewDecoder(resp.Body).Decode(&provisioner); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		resp, err := client.DeleteProvisioner(context.Background(), authorityID, *provisioner.Id, &v20250101.DeleteProvisionerParams{})
+		require.NoError(t, err)
+		assert.Equal(t, 204, resp.StatusCode)
+	})
+
+	return provisioner
+}
+
 func NewJWK(t *testing.T, pass string) (string, string) {
 	jwk, jwe, err := jose.GenerateDefaultKeyPair([]byte(pass))
 	require.NoError(t, err)
@@ -220,7 +310,7 @@ func NewWebhook(t *testing.T, provisionerID, authorityID string) *v20250101.Prov
 func Slug(t *testing.T) string {
 	slug, err := randutil.String(10, "abcdefghijklmnopqrstuvwxyz0123456789")
 	require.NoError(t, err)
-	return "tfprovider" + slug
+	return "tfprovider-" + slug
 }
 
 func IP(t *testing.T) string {
@@ -248,7 +338,7 @@ func NewDevice(t *testing.T) *v20250101.Device {
 	require.NoError(t, err)
 
 	req := v20250101.DeviceRequest{
-		PermanentIdentifier: permanentID,
+		PermanentIdentifier: "tfprovider-" + permanentID,
 		DisplayId:           Ref(displayID),
 		DisplayName:         Ref(deviceName),
 		Metadata: &v20250101.DeviceMetadata{
@@ -484,3 +574,110 @@ func NewCredential(t *testing.T) *v20250101.Credential {
 
 	return c
 }
+
+// NullState returns the state of a resource that doesn't exist yet, as when it
+// is read after create or import.
+func NullState(t *testing.T, r resource.Resource) tfsdk.State {
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// AssertRoundTrip checks that an API object converted to a model and back is
+// unchanged. Like the ToOptional helpers, it treats missing, null, zero and
+// empty values the same.
+func AssertRoundTrip(t *testing.T, want, got any, msgAndArgs ...any) bool {
+	t.Helper()
+	return assert.Equal(t, normalizeJSON(t, want), normalizeJSON(t, got), msgAndArgs...)
+}
+
+// RoundTrip tests that random objects of a spec schema are unchanged after
+// they're converted to a model and back.
+type RoundTrip[T any] struct {
+	// Version is the API version of the spec, e.g. "2026-05-01".
+	Version string
+	// Schema is the name of the spec schema the objects are generated from.
+	Schema string
+	// Seeds is the number of objects to test. It defaults to 200.
+	Seeds uint64
+	// Prepare is optional and adjusts a generated object to one the resource
+	// manages. Objects it returns false for are skipped.
+	Prepare func(t *testing.T, obj *T) bool
+	// Convert converts an object to a model and back.
+	Convert func(t *testing.T, obj *T) any
+	// Want is optional and returns the object Convert's result is compared
+	// with, usually the object without its read-only properties.
+	Want func(t *testing.T, obj *T) any
+}
+
+// Run runs the test, with a subtest for each seed.
+func (rt RoundTrip[T]) Run(t *testing.T) {
+	t.Helper()
+	seeds := cmp.Or(rt.Seeds, 200)
+	for seed := range seeds {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			rng := randv2.New(randv2.NewPCG(seed, 0))
+			var obj T
+			require.NoError(t, apispec.Random(rng, rt.Version, rt.Schema, &obj))
+			if rt.Prepare != nil && !rt.Prepare(t, &obj) {
+				return
+			}
+
+			got := rt.Convert(t, &obj)
+			var want any = obj
+			if rt.Want != nil {
+				want = rt.Want(t, &obj)
+			}
+			AssertRoundTrip(t, want, got)
+		})
+	}
+}
+
+func normalizeJSON(t *testing.T, v any) any {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	var out any
+	require.NoError(t, json.Unmarshal(b, &out))
+	return removeEmpty(out)
+}
+
+func removeEmpty(v any) any {
+	switch v := v.(type) {
+	case bool:
+		if !v {
+			return nil
+		}
+	case string:
+		if v == "" {
+			return nil
+		}
+	case float64:
+		if v == 0 {
+			return nil
+		}
+	case map[string]any:
+		for k, val := range v {
+			if val = removeEmpty(val); val == nil {
+				delete(v, k)
+			} else {
+				v[k] = val
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []any:
+		for i := range v {
+			v[i] = removeEmpty(v[i])
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	}
+	return v
+}
@@ -0,0 +1,129 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Duration validates that a string is a Go duration such as "24h" or "5m30s".
+// An empty string is allowed since it is the same as not setting the
+// attribute.
+func Duration() validator.String {
+	return durationValidator{}
+}
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return `value must be a duration such as "24h" or "5m30s"`
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s: %s.", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// DurationAtLeast validates that a duration is at least as long as the
+// durations of the attributes matching the expressions, e.g. that a maximum
+// isn't below the minimum. Attributes that are null, unknown or not durations
+// are ignored.
+func DurationAtLeast(expressions ...path.Expression) validator.String {
+	return durationOrderValidator{
+		expressions: expressions,
+		atLeast:     true,
+	}
+}
+
+// DurationAtMost validates that a duration is no longer than the durations of
+// the attributes matching the expressions, e.g. that a default isn't above the
+// maximum. Attributes that are null, unknown or not durations are ignored.
+func DurationAtMost(expressions ...path.Expression) validator.String {
+	return durationOrderValidator{
+		expressions: expressions,
+	}
+}
+
+type durationOrderValidator struct {
+	expressions path.Expressions
+	atLeast     bool
+}
+
+func (v durationOrderValidator) Description(_ context.Context) string {
+	if v.atLeast {
+		return fmt.Sprintf("value must be a duration at least as long as %s", v.expressions)
+	}
+	return fmt.Sprintf("value must be a duration no longer than %s", v.expressions)
+}
+
+func (v durationOrderValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationOrderValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		// Left to the Duration validator.
+		return
+	}
+
+	for _, expression := range req.PathExpression.MergeExpressions(v.expressions...) {
+		matches, diags := req.Config.PathMatches(ctx, expression)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		for _, match := range matches {
+			if match.Equal(req.Path) {
+				continue
+			}
+
+			var other types.String
+			diags := req.Config.GetAttribute(ctx, match, &other)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() || other.IsNull() || other.IsUnknown() {
+				continue
+			}
+			bound, err := time.ParseDuration(other.ValueString())
+			if err != nil {
+				continue
+			}
+
+			if v.atLeast && d < bound {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Duration",
+					fmt.Sprintf("Attribute %s must be at least %s, the value of %s, got: %s.", req.Path, other.ValueString(), match, req.ConfigValue.ValueString()),
+				)
+			}
+			if !v.atLeast && d > bound {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Duration",
+					fmt.Sprintf("Attribute %s must be at most %s, the value of %s, got: %s.", req.Path, other.ValueString(), match, req.ConfigValue.ValueString()),
+				)
+			}
+		}
+	}
+}
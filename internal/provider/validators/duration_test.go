@@ -0,0 +1,97 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{"hours", types.StringValue("24h"), false},
+		{"mixed", types.StringValue("1h5m30s"), false},
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+		{"empty", types.StringValue(""), false},
+		{"days", types.StringValue("1d"), true},
+		{"no unit", types.StringValue("60"), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			Duration().ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("duration"),
+				ConfigValue: tc.value,
+			}, resp)
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestDurationOrder(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"min":     schema.StringAttribute{Optional: true},
+			"default": schema.StringAttribute{Optional: true},
+			"max":     schema.StringAttribute{Optional: true},
+		},
+	}
+	config := func(min, def, max tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"min":     min,
+				"default": def,
+				"max":     max,
+			}),
+		}
+	}
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	null := tftypes.NewValue(tftypes.String, nil)
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	tests := []struct {
+		name    string
+		config  tfsdk.Config
+		wantErr bool
+	}{
+		{"ordered", config(str("5m"), str("24h"), str("168h")), false},
+		{"equal", config(str("24h"), str("24h"), str("24h")), false},
+		{"different units", config(str("60m"), str("1h"), str("3600s")), false},
+		{"no bounds", config(null, str("24h"), null), false},
+		{"unknown bounds", config(unknown, str("24h"), unknown), false},
+		{"invalid bound", config(str("1d"), str("24h"), null), false},
+		{"empty bound", config(str(""), str("24h"), str("")), false},
+		{"below min", config(str("48h"), str("24h"), null), true},
+		{"above max", config(null, str("24h"), str("1h")), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var def types.String
+			tc.config.GetAttribute(ctx, path.Root("default"), &def)
+			req := validator.StringRequest{
+				Path:           path.Root("default"),
+				PathExpression: path.MatchRoot("default"),
+				ConfigValue:    def,
+				Config:         tc.config,
+			}
+
+			resp := &validator.StringResponse{}
+			DurationAtLeast(path.MatchRelative().AtParent().AtName("min")).ValidateString(ctx, req, resp)
+			DurationAtMost(path.MatchRelative().AtParent().AtName("max")).ValidateString(ctx, req, resp)
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"go.step.sm/crypto/jose"
+)
+
+// JWKPublicKey validates that a string is a JSON Web Key with a public key.
+// Private and symmetric keys are rejected so they don't end up in a
+// provisioner's public configuration.
+func JWKPublicKey() validator.String {
+	return jwkPublicKeyValidator{}
+}
+
+type jwkPublicKeyValidator struct{}
+
+func (v jwkPublicKeyValidator) Description(_ context.Context) string {
+	return "value must be a public JSON Web Key"
+}
+
+func (v jwkPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jwkPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parsePublicJWK([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Web Key",
+			fmt.Sprintf("Attribute %s %s: %s.", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+func parsePublicJWK(b []byte) error {
+	var jwk jose.JSONWebKey
+	if err := json.Unmarshal(b, &jwk); err != nil {
+		return err
+	}
+	if !jwk.Valid() {
+		return fmt.Errorf("the key is not valid")
+	}
+	if !jwk.IsPublic() {
+		return fmt.Errorf("the key is private or symmetric")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.step.sm/crypto/jose"
+)
+
+func TestJWKPublicKey(t *testing.T) {
+	pub, _ := utils.NewJWK(t, "pass")
+
+	priv, err := jose.GenerateJWK("EC", "P-256", "ES256", "sig", "", 0)
+	require.NoError(t, err)
+	privJSON, err := priv.MarshalJSON()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{"public", types.StringValue(pub), false},
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+		{"private", types.StringValue(string(privJSON)), true},
+		{"symmetric", types.StringValue(`{"kty":"oct","k":"c2VjcmV0"}`), true},
+		{"not json", types.StringValue("ssh-ed25519 AAAA"), true},
+		{"empty object", types.StringValue("{}"), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			JWKPublicKey().ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("key"),
+				ConfigValue: tc.value,
+			}, resp)
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package validators
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// PEMCertificates validates that a string is one or more PEM encoded X.509
+// certificates, as for a root or a CA chain.
+func PEMCertificates() validator.String {
+	return pemCertificatesValidator{}
+}
+
+type pemCertificatesValidator struct{}
+
+func (v pemCertificatesValidator) Description(_ context.Context) string {
+	return "value must be one or more PEM encoded certificates"
+}
+
+func (v pemCertificatesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pemCertificatesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseCertificates([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Certificates",
+			fmt.Sprintf("Attribute %s %s: %s.", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+func parseCertificates(rest []byte) error {
+	n := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		n++
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("block %d is a %s, not a CERTIFICATE", n, block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("certificate %d: %w", n, err)
+		}
+	}
+	if n == 0 {
+		return fmt.Errorf("no PEM encoded certificate found")
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return fmt.Errorf("unexpected data after certificate %d", n)
+	}
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -38,16 +38,16 @@ var ikeV2ConfigAttrTypes = map[string]attr.Type{
 	"remote_id": types.StringType,
 }
 
-func (model *VpnModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20250101.Vpn {
-	vpn := &v20250101.Vpn{
+func (model *VpnModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20260501.Vpn {
+	vpn := &v20260501.Vpn{
 		Name:           model.Name.ValueStringPointer(),
-		ConnectionType: v20250101.VpnType(model.ConnectionType.ValueString()),
+		ConnectionType: v20260501.VpnType(model.ConnectionType.ValueString()),
 		RemoteAddress:  model.RemoteAddress.ValueString(),
 		Autojoin:       model.Autojoin.ValueBoolPointer(),
 	}
 
 	if !model.Vendor.IsNull() && !model.Vendor.IsUnknown() {
-		vendor := v20250101.VpnVendor(model.Vendor.ValueString())
+		vendor := v20260501.VpnVendor(model.Vendor.ValueString())
 		vpn.Vendor = &vendor
 	}
 
@@ -56,7 +56,7 @@ func (model *VpnModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v202
 		d := model.Ike.As(ctx, &ikeModel, basetypes.ObjectAsOptions{})
 		diags.Append(d...)
 		if !diags.HasError() {
-			vpn.Ike = &v20250101.IkeV2Config{
+			vpn.Ike = &v20260501.IkeV2Config{
 				CaChain:  ikeModel.CaChain.ValueString(),
 				Eap:      ikeModel.Eap.ValueBoolPointer(),
 				RemoteID: ikeModel.RemoteID.ValueStringPointer(),
@@ -74,7 +74,7 @@ func (model *VpnModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v202
 	return vpn
 }
 
-func FromAPI(ctx context.Context, vpn *v20250101.Vpn, diags *diag.Diagnostics, state utils.AttributeGetter) *VpnModel {
+func FromAPI(ctx context.Context, vpn *v20260501.Vpn, diags *diag.Diagnostics, state utils.AttributeGetter) *VpnModel {
 	model := &VpnModel{
 		ID:             types.StringPointerValue(vpn.Id),
 		ConnectionType: types.StringValue(string(vpn.ConnectionType)),
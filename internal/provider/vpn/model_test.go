@@ -0,0 +1,33 @@
+package vpn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+
+	utils.RoundTrip[v20260501.Vpn]{
+		Version: "2026-05-01",
+		Schema:  "vpn",
+		Convert: func(t *testing.T, vpn *v20260501.Vpn) any {
+			var diags diag.Diagnostics
+			model := FromAPI(ctx, vpn, &diags, state)
+			got := model.ToAPI(ctx, &diags)
+			require.False(t, diags.HasError(), diags)
+			return got
+		},
+		Want: func(t *testing.T, vpn *v20260501.Vpn) any {
+			// The ID is read-only.
+			vpn.Id = nil
+			return vpn
+		},
+	}.Run(t)
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
@@ -24,11 +26,11 @@ func NewResource() resource.Resource {
 }
 
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	vpn, props, err := utils.Describe("vpn")
+	vpn, props, err := utils.DescribeV20260501("vpn")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI VPN Schema",
@@ -79,6 +81,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					"ca_chain": schema.StringAttribute{
 						MarkdownDescription: "The certificate authority bundle that client certificates must chain up to.",
 						Required:            true,
+						Validators: []validator.String{
+							validators.PEMCertificates(),
+						},
 					},
 					"eap": schema.BoolAttribute{
 						MarkdownDescription: "Whether or not EAP is enforced on this VPN server.",
@@ -120,10 +125,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, name, "read")
+
 	state := &VpnModel{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -140,7 +147,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	httpResp, err := r.client.GetVpn(ctx, vpnID, &v20250101.GetVpnParams{})
+	httpResp, err := r.client.GetVpn(ctx, vpnID, &v20260501.GetVpnParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -163,7 +170,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	vpn := &v20250101.Vpn{}
+	vpn := &v20260501.Vpn{}
 	if err := json.NewDecoder(httpResp.Body).Decode(vpn); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -181,6 +188,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "create")
+
 	plan := &VpnModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -192,7 +201,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PostVpn(ctx, &v20250101.PostVpnParams{}, *reqBody)
+	httpResp, err := r.client.PostVpn(ctx, &v20260501.PostVpnParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -211,7 +220,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	vpn := &v20250101.Vpn{}
+	vpn := &v20260501.Vpn{}
 	if err := json.NewDecoder(httpResp.Body).Decode(vpn); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -229,6 +238,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "update")
+
 	plan := &VpnModel{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
@@ -242,7 +253,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PutVpn(ctx, vpnID, &v20250101.PutVpnParams{}, *reqBody)
+	httpResp, err := r.client.PutVpn(ctx, vpnID, &v20260501.PutVpnParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -266,7 +277,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	vpn := &v20250101.Vpn{}
+	vpn := &v20260501.Vpn{}
 	if err := json.NewDecoder(httpResp.Body).Decode(vpn); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -282,6 +293,8 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, name, "delete")
+
 	state := &VpnModel{}
 	diags := req.State.Get(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -298,7 +311,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.DeleteVpn(ctx, vpnID, &v20250101.DeleteVpnParams{})
+	httpResp, err := r.client.DeleteVpn(ctx, vpnID, &v20260501.DeleteVpnParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
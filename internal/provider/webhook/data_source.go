@@ -10,8 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -41,7 +41,7 @@ func NewDataSource() datasource.DataSource {
 
 // DataSource implements data.smallstep_provisioner_webhook
 type DataSource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (a *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -65,10 +65,12 @@ func (a *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	a.client = clients.V20250101
+	a.client = clients.Pinned
 }
 
 func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, "data."+typeName, "read")
+
 	var config DataModel
 
 	// Read Terraform configuration data into the model
@@ -84,7 +86,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	if config.ID.IsNull() {
 		idOrName = config.Name.ValueString()
 	}
-	httpResp, err := a.client.GetWebhook(ctx, authorityID, provisionerID, idOrName, &v20250101.GetWebhookParams{})
+	httpResp, err := a.client.GetWebhook(ctx, authorityID, provisionerID, idOrName, &v20260501.GetWebhookParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -103,7 +105,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	webhook := &v20250101.ProvisionerWebhook{}
+	webhook := &v20260501.ProvisionerWebhook{}
 	if err := json.NewDecoder(httpResp.Body).Decode(webhook); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -130,7 +132,7 @@ func (a *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 }
 
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	component, props, err := utils.Describe("provisionerWebhook")
+	component, props, err := utils.DescribeV20260501("provisionerWebhook")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI spec",
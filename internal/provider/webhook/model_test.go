@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+
+	utils.RoundTrip[v20260501.ProvisionerWebhook]{
+		Version: "2026-05-01",
+		Schema:  "provisionerWebhook",
+		Prepare: func(t *testing.T, webhook *v20260501.ProvisionerWebhook) bool {
+			// The API never returns these, so they are kept from state.
+			webhook.BearerToken = nil
+			webhook.BasicAuth = nil
+			webhook.CollectionSlug = nil
+			return true
+		},
+		Convert: func(t *testing.T, webhook *v20260501.ProvisionerWebhook) any {
+			model, diags := fromAPI(ctx, webhook, state)
+			require.False(t, diags.HasError(), diags)
+			return toAPI(model)
+		},
+	}.Run(t)
+}
@@ -16,8 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -29,7 +29,7 @@ func NewResource() resource.Resource {
 
 // Resource defines the resource implementation.
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,10 +53,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "read")
+
 	state := &Model{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -71,7 +73,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	if idOrName == "" {
 		idOrName = state.Name.ValueString()
 	}
-	httpResp, err := r.client.GetWebhook(ctx, authorityID, provisionerID, idOrName, &v20250101.GetWebhookParams{})
+	httpResp, err := r.client.GetWebhook(ctx, authorityID, provisionerID, idOrName, &v20260501.GetWebhookParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -95,7 +97,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	webhook := &v20250101.ProvisionerWebhook{}
+	webhook := &v20260501.ProvisionerWebhook{}
 	if err := json.NewDecoder(httpResp.Body).Decode(webhook); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -118,7 +120,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	component, props, err := utils.Describe("provisionerWebhook")
+	component, props, err := utils.DescribeV20260501("provisionerWebhook")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI spec",
@@ -126,7 +128,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		)
 		return
 	}
-	basicAuth, basicAuthProps, err := utils.Describe("basicAuth")
+	basicAuth, basicAuthProps, err := utils.DescribeV20260501("basicAuth")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI spec",
@@ -257,6 +259,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 }
 
 func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "create")
+
 	var plan Model
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -267,13 +271,10 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	reqBody := toAPI(&plan)
 
-	b, _ := json.Marshal(reqBody)
-	tflog.Trace(ctx, string(b))
-
 	authorityID := plan.AuthorityID.ValueString()
 	provisionerID := plan.ProvisionerID.ValueString()
 
-	httpResp, err := a.client.PostWebhooks(ctx, authorityID, provisionerID, &v20250101.PostWebhooksParams{}, *reqBody)
+	httpResp, err := a.client.PostWebhooks(ctx, authorityID, provisionerID, &v20260501.PostWebhooksParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -292,7 +293,7 @@ func (a *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	webhook := &v20250101.ProvisionerWebhook{}
+	webhook := &v20260501.ProvisionerWebhook{}
 	if err := json.NewDecoder(httpResp.Body).Decode(webhook); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -319,6 +320,8 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (a *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, typeName, "delete")
+
 	var state Model
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -331,11 +334,11 @@ func (a *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	if nameOrID == "" {
 		nameOrID = state.Name.ValueString()
 	}
-	httpResp, err := a.client.DeleteProvisioner(ctx, state.AuthorityID.ValueString(), nameOrID, &v20250101.DeleteProvisionerParams{})
+	httpResp, err := a.client.DeleteWebhook(ctx, state.AuthorityID.ValueString(), state.ProvisionerID.ValueString(), nameOrID, &v20260501.DeleteWebhookParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
-			fmt.Sprintf("Failed to delete provisioner %s: %v", state.ID.String(), err),
+			fmt.Sprintf("Failed to delete webhook %s: %v", state.ID.String(), err),
 		)
 		return
 	}
@@ -345,7 +348,7 @@ func (a *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		reqID := httpResp.Header.Get("X-Request-Id")
 		resp.Diagnostics.AddError(
 			"Smallstep API Response Error",
-			fmt.Sprintf("Request %q received status %d deleting provisioner %s: %s", reqID, httpResp.StatusCode, state.ID.String(), utils.APIErrorMsg(httpResp.Body)),
+			fmt.Sprintf("Request %q received status %d deleting webhook %s: %s", reqID, httpResp.StatusCode, state.ID.String(), utils.APIErrorMsg(httpResp.Body)),
 		)
 		return
 	}
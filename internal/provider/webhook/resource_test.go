@@ -1,18 +1,27 @@
 package webhook
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/provisioner"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 	"github.com/smallstep/terraform-provider-smallstep/internal/testprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var provider = &testprovider.SmallstepTestProvider{
@@ -148,3 +157,48 @@ resource "smallstep_provisioner_webhook" "hosted" {
 		},
 	})
 }
+
+// Deleting a webhook must not delete the provisioner it belongs to.
+func TestResourceDelete(t *testing.T) {
+	ctx := context.Background()
+	authority := utils.NewAuthority(t)
+	provisioner, _ := utils.NewOIDCProvisioner(t, authority.Id)
+	webhook := utils.NewWebhook(t, *provisioner.Id, authority.Id)
+
+	client, err := utils.SmallstepAPIClientFromEnv()
+	require.NoError(t, err)
+	pinned, err := utils.SmallstepAPIClientPinnedFromEnv()
+	require.NoError(t, err)
+	r := NewResource().(*Resource)
+	configureResp := &resource.ConfigureResponse{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: &clientset.Clients{V20250101: client, Pinned: pinned}}, configureResp)
+	require.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for p, v := range map[string]string{
+		"id":             *webhook.Id,
+		"authority_id":   authority.Id,
+		"provisioner_id": *provisioner.Id,
+		"name":           webhook.Name,
+	} {
+		require.False(t, state.SetAttribute(ctx, path.Root(p), v).HasError())
+	}
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	httpResp, err := client.GetWebhook(ctx, authority.Id, *provisioner.Id, *webhook.Id, &v20250101.GetWebhookParams{})
+	require.NoError(t, err)
+	httpResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+
+	httpResp, err = client.GetProvisioner(ctx, authority.Id, *provisioner.Id, &v20250101.GetProvisionerParams{})
+	require.NoError(t, err)
+	httpResp.Body.Close()
+	assert.Equal(t, http.StatusOK, httpResp.StatusCode)
+}
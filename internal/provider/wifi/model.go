@@ -7,7 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
 )
 
@@ -24,8 +24,8 @@ type WifiModel struct {
 	Credentials        types.Set    `tfsdk:"credentials"`
 }
 
-func (model *WifiModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20250101.Wifi {
-	wifi := &v20250101.Wifi{
+func (model *WifiModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20260501.Wifi {
+	wifi := &v20260501.Wifi{
 		Name:               model.Name.ValueStringPointer(),
 		Ssid:               model.SSID.ValueString(),
 		RadiusServerCA:     model.RadiusServerCA.ValueString(),
@@ -44,7 +44,7 @@ func (model *WifiModel) ToAPI(ctx context.Context, diags *diag.Diagnostics) *v20
 	return wifi
 }
 
-func FromAPI(ctx context.Context, wifi *v20250101.Wifi, diags *diag.Diagnostics, state utils.AttributeGetter) *WifiModel {
+func FromAPI(ctx context.Context, wifi *v20260501.Wifi, diags *diag.Diagnostics, state utils.AttributeGetter) *WifiModel {
 	model := &WifiModel{
 		ID:             types.StringPointerValue(wifi.Id),
 		SSID:           types.StringValue(wifi.Ssid),
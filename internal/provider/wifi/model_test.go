@@ -0,0 +1,33 @@
+package wifi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	state := utils.NullState(t, NewResource())
+
+	utils.RoundTrip[v20260501.Wifi]{
+		Version: "2026-05-01",
+		Schema:  "wifi",
+		Convert: func(t *testing.T, wifi *v20260501.Wifi) any {
+			var diags diag.Diagnostics
+			model := FromAPI(ctx, wifi, &diags, state)
+			got := model.ToAPI(ctx, &diags)
+			require.False(t, diags.HasError(), diags)
+			return got
+		},
+		Want: func(t *testing.T, wifi *v20260501.Wifi) any {
+			// The ID is read-only.
+			wifi.Id = nil
+			return wifi
+		},
+	}.Run(t)
+}
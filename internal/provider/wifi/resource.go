@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v20250101 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20250101"
 	"github.com/smallstep/terraform-provider-smallstep/internal/apiclient/clientset"
+	v20260501 "github.com/smallstep/terraform-provider-smallstep/internal/apiclient/v20260501"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider/utils"
+	"github.com/smallstep/terraform-provider-smallstep/internal/provider/validators"
 )
 
 var _ resource.ResourceWithImportState = (*Resource)(nil)
@@ -24,11 +26,11 @@ func NewResource() resource.Resource {
 }
 
 type Resource struct {
-	client *v20250101.Client
+	client *v20260501.Client
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	wifi, props, err := utils.Describe("wifi")
+	wifi, props, err := utils.DescribeV20260501("wifi")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Parse Smallstep OpenAPI Wifi Schema",
@@ -63,6 +65,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"radius_server_ca": schema.StringAttribute{
 				MarkdownDescription: props["radiusServerCA"],
 				Required:            true,
+				Validators: []validator.String{
+					validators.PEMCertificates(),
+				},
 			},
 			"radius_server_domain": schema.StringAttribute{
 				MarkdownDescription: props["radiusServerDomain"],
@@ -106,10 +111,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = clients.V20250101
+	r.client = clients.Pinned
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = utils.WithRequestID(ctx, name, "read")
+
 	state := &WifiModel{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -126,7 +133,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	httpResp, err := r.client.GetWifi(ctx, wifiID, &v20250101.GetWifiParams{})
+	httpResp, err := r.client.GetWifi(ctx, wifiID, &v20260501.GetWifiParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -149,7 +156,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	wifi := &v20250101.Wifi{}
+	wifi := &v20260501.Wifi{}
 	if err := json.NewDecoder(httpResp.Body).Decode(wifi); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -167,6 +174,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "create")
+
 	plan := &WifiModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -178,7 +187,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PostWifi(ctx, &v20250101.PostWifiParams{}, *reqBody)
+	httpResp, err := r.client.PostWifi(ctx, &v20260501.PostWifiParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -197,7 +206,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	wifi := &v20250101.Wifi{}
+	wifi := &v20260501.Wifi{}
 	if err := json.NewDecoder(httpResp.Body).Decode(wifi); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -215,6 +224,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = utils.WithRequestID(ctx, name, "update")
+
 	plan := &WifiModel{}
 	diags := req.Plan.Get(ctx, plan)
 	if diags.HasError() {
@@ -228,7 +239,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.PutWifi(ctx, wifiID, &v20250101.PutWifiParams{}, *reqBody)
+	httpResp, err := r.client.PutWifi(ctx, wifiID, &v20260501.PutWifiParams{}, *reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -252,7 +263,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	wifi := &v20250101.Wifi{}
+	wifi := &v20260501.Wifi{}
 	if err := json.NewDecoder(httpResp.Body).Decode(wifi); err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
@@ -268,6 +279,8 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = utils.WithRequestID(ctx, name, "delete")
+
 	state := &WifiModel{}
 	diags := req.State.Get(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -284,7 +297,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	httpResp, err := r.client.DeleteWifi(ctx, wifiID, &v20250101.DeleteWifiParams{})
+	httpResp, err := r.client.DeleteWifi(ctx, wifiID, &v20260501.DeleteWifiParams{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Smallstep API Client Error",
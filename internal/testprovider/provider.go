@@ -2,7 +2,6 @@ package testprovider
 
 import (
 	"context"
-	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -31,11 +30,6 @@ func (p *SmallstepTestProvider) Schema(ctx context.Context, req provider.SchemaR
 }
 
 func (p *SmallstepTestProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	server := os.Getenv("SMALLSTEP_API_URL")
-	if server == "" {
-		server = "https://gateway.smallstep.com/api"
-	}
-
 	client25, err := utils.SmallstepAPIClientFromEnv()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -54,9 +48,20 @@ func (p *SmallstepTestProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
+	pinned, err := utils.SmallstepAPIClientPinnedFromEnv()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Get pinned API client configured from environment",
+			err.Error(),
+		)
+		return
+	}
+
 	clients := &clientset.Clients{
-		V20250101: client25,
-		V20260501: client26,
+		V20250101:  client25,
+		V20260501:  client26,
+		Pinned:     pinned,
+		APIVersion: utils.APIVersionFromEnv(),
 	}
 	resp.DataSourceData = clients
 	resp.ResourceData = clients
@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/smallstep/terraform-provider-smallstep/internal/cli"
 	"github.com/smallstep/terraform-provider-smallstep/internal/provider"
 )
 
@@ -29,6 +31,15 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "export":
+			os.Exit(cli.Export(context.Background(), version, os.Args[2:], os.Stdout, os.Stderr))
+		case "drift":
+			os.Exit(cli.Drift(context.Background(), version, os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+		}
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")